@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/template"
+)
+
+// defaultBoardTemplate is used for `gci board --template default`
+const defaultBoardTemplate = `{{.Scope}} — {{join .Projects ", "}}
+{{range .Columns}}
+{{.Title}} ({{len .Issues}})
+{{range .Issues}}{{if .Subtask}}    └─ {{else}}  • {{end}}{{.Key}} {{.Summary}}{{if .Assignee}} (@{{.Assignee}}){{end}}
+{{else}}  (none)
+{{end}}{{end}}`
+
+// boardTemplateData is the root object passed to board templates
+type boardTemplateData struct {
+	Scope    string
+	Projects []string
+	Columns  []boardTemplateColumn
+}
+
+type boardTemplateColumn struct {
+	Title  string
+	Issues []boardTemplateIssue
+}
+
+// boardTemplateIssue is a flattened issue so templates don't depend on JIRA's field layout
+type boardTemplateIssue struct {
+	Key      string
+	Summary  string
+	Status   string
+	Assignee string
+	Priority string
+	Type     string
+	Parent   string
+	Subtask  bool
+	URL      string
+}
+
+// newBoardTemplateData converts board columns (already filtered and grouped) for templates
+func newBoardTemplateData(cfg *Config, scope scopeFilter, columns []kanbanColumnView) boardTemplateData {
+	data := boardTemplateData{Scope: scopeToString(scope), Projects: cfg.Projects}
+	for _, c := range columns {
+		col := boardTemplateColumn{Title: c.title}
+		for _, it := range c.issues {
+			col.Issues = append(col.Issues, boardTemplateIssue{
+				Key:      it.Key,
+				Summary:  it.Fields.Summary,
+				Status:   it.Fields.Status.Name,
+				Assignee: it.Fields.Assignee.DisplayName,
+				Priority: it.Fields.Priority.Name,
+				Type:     it.Fields.IssueType.Name,
+				Parent:   it.Fields.Parent.Key,
+				Subtask:  it.Fields.IssueType.Subtask,
+				URL:      issueURL(cfg, it.Key),
+			})
+		}
+		data.Columns = append(data.Columns, col)
+	}
+	return data
+}
+
+// executeBoardTemplate parses text as a board template and renders data to w
+func executeBoardTemplate(w io.Writer, text string, data boardTemplateData) error {
+	tmpl, err := template.New("board").Funcs(template.FuncMap{"join": strings.Join}).Parse(text)
+	if err != nil {
+		return fmt.Errorf("invalid template: %w", err)
+	}
+	return tmpl.Execute(w, data)
+}
+
+// renderBoardTemplate fetches the board once (same scope and grouping as the TUI) and
+// renders it with the template at path, or the built-in one for "default"
+func renderBoardTemplate(cfg *Config, path string, w io.Writer) error {
+	text := defaultBoardTemplate
+	if path != "default" {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read template: %w", err)
+		}
+		text = string(b)
+	}
+
+	resolveStatusCategoryNames(cfg)
+	model := initialBoardModel(cfg)
+	switch msg := model.loadColumnsConcurrently(*cfg, model.columns, model.curScope, "").(type) {
+	case errMsg:
+		return msg.err
+	case dataLoadedMsg:
+		return executeBoardTemplate(w, text, newBoardTemplateData(cfg, model.curScope, msg.columns))
+	default:
+		return fmt.Errorf("unexpected board load result")
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestExecuteBoardTemplate(t *testing.T) {
+	cfg := &Config{JiraURL: "https://test.atlassian.net", Projects: []string{"TEST", "OPS"}}
+
+	parent := JiraIssue{Key: "TEST-1"}
+	parent.Fields.Summary = "Parent work"
+	parent.Fields.Assignee.DisplayName = "Ada"
+	sub := JiraIssue{Key: "TEST-2"}
+	sub.Fields.Summary = "Child work"
+	sub.Fields.IssueType.Subtask = true
+	sub.Fields.Parent.Key = "TEST-1"
+
+	columns := []kanbanColumnView{
+		{title: "To Do", issues: []JiraIssue{parent, sub}},
+		{title: "Done"},
+	}
+	data := newBoardTemplateData(cfg, scopeMine, columns)
+
+	var out bytes.Buffer
+	if err := executeBoardTemplate(&out, defaultBoardTemplate, data); err != nil {
+		t.Fatalf("default template failed: %v", err)
+	}
+	for _, want := range []string{"Assigned to Me — TEST, OPS", "To Do (2)", "• TEST-1 Parent work (@Ada)", "└─ TEST-2 Child work", "Done (0)", "(none)"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, out.String())
+		}
+	}
+
+	out.Reset()
+	custom := `{{range .Columns}}{{range .Issues}}<{{.URL}}|{{.Key}}>{{end}}{{end}}`
+	if err := executeBoardTemplate(&out, custom, data); err != nil {
+		t.Fatalf("custom template failed: %v", err)
+	}
+	if !strings.HasPrefix(out.String(), "<https://test.atlassian.net/browse/TEST-1|TEST-1>") {
+		t.Errorf("Unexpected custom output: %s", out.String())
+	}
+
+	if err := executeBoardTemplate(&out, "{{.Nope", data); err == nil {
+		t.Error("Expected parse error for invalid template")
+	}
+}
@@ -2,12 +2,20 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"hash/fnv"
 	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
+	"gci/internal/httputil"
+	"gci/internal/jira"
 	"gci/internal/usercfg"
+	"gci/internal/version"
 
 	"github.com/atotto/clipboard"
 	textinput "github.com/charmbracelet/bubbles/textinput"
@@ -33,10 +41,224 @@ type errMsg struct{ err error }
 
 type clearStatusMsg struct{}
 
+// issueDetailsLoadedMsg carries on-demand details for the expanded issue
+type issueDetailsLoadedMsg struct {
+	issue JiraIssue
+	err   error
+}
+
+// rowLayout is the board's information density (z cycles)
+type rowLayout int
+
+const (
+	rowCompact  rowLayout = iota // KEY — summary
+	rowNormal                    // plus assignee/priority tags
+	rowDetailed                  // plus a second line with status and last update
+)
+
+func (l rowLayout) String() string {
+	switch l {
+	case rowNormal:
+		return "Normal"
+	case rowDetailed:
+		return "Detailed"
+	default:
+		return "Compact"
+	}
+}
+
+// configString is the row_layout value saved in UI preferences
+func (l rowLayout) configString() string {
+	return strings.ToLower(l.String())
+}
+
+// rowHeight is the number of lines each issue takes in this layout
+func (l rowLayout) rowHeight() int {
+	if l == rowDetailed {
+		return 2
+	}
+	return 1
+}
+
+// rowLayoutFromPrefs reads row_layout, falling back to the older show_extra_fields toggle
+func rowLayoutFromPrefs(prefs usercfg.UIPreferences) rowLayout {
+	switch prefs.RowLayout {
+	case "compact":
+		return rowCompact
+	case "normal":
+		return rowNormal
+	case "detailed":
+		return rowDetailed
+	}
+	if prefs.ShowExtraFields {
+		return rowNormal
+	}
+	return rowCompact
+}
+
+// separate_backlog modes: which columns list backlog-status issues after active ones
+const (
+	separateBacklogOff      = "off"
+	separateBacklogTodoOnly = "todo-only" // default
+	separateBacklogAll      = "all"
+)
+
+var validSeparateBacklogModes = []string{separateBacklogOff, separateBacklogTodoOnly, separateBacklogAll}
+
+// separatesBacklog reports whether the column titled title groups backlog issues apart
+func separatesBacklog(mode, title string) bool {
+	switch mode {
+	case separateBacklogOff:
+		return false
+	case separateBacklogAll:
+		return true
+	default:
+		return title == "To Do"
+	}
+}
+
+// isBacklogIssue matches statuses like "Backlog" or "Product Backlog"
+func isBacklogIssue(it JiraIssue) bool {
+	return strings.Contains(strings.ToLower(it.Fields.Status.Name), "backlog")
+}
+
+// hierarchyMode controls how subtasks are shown relative to their parents
+type hierarchyMode int
+
+const (
+	hierarchyGrouped     hierarchyMode = iota // subtasks indented under parents
+	hierarchyFlat                             // plain recency order, no indentation
+	hierarchyParentsOnly                      // subtasks hidden; parents show a hidden count
+)
+
+func (h hierarchyMode) String() string {
+	switch h {
+	case hierarchyFlat:
+		return "Flat"
+	case hierarchyParentsOnly:
+		return "Parents only"
+	default:
+		return "Grouped"
+	}
+}
+
+// issueCreatedMsg reports the result of a board quick create
+type issueCreatedMsg struct {
+	issue JiraIssue
+	err   error
+}
+
+// usersFoundMsg carries user search results for the reassign picker
+type usersFoundMsg struct {
+	users []jiraUser
+	err   error
+}
+
+// issueAssignedMsg reports the result of reassigning an issue from the board
+type issueAssignedMsg struct {
+	key  string
+	user jiraUser
+	err  error
+}
+
+// worklogAddedMsg reports the result of logging work from the board
+type worklogAddedMsg struct {
+	key   string
+	spent string
+	err   error
+}
+
+// bulkTransitionMsg reports one issue's outcome during a bulk transition
+type bulkTransitionMsg struct {
+	issue   JiraIssue
+	skipped bool
+	reason  string
+	err     error
+}
+
+// startWorkMsg reports S: the checkout, with git's captured progress, then the transition
+type startWorkMsg struct {
+	issue       JiraIssue
+	branch      string
+	notes       string // what the checkout would have printed, on one line
+	checkoutErr error  // the branch wasn't checked out, so nothing was transitioned
+	skipped     bool
+	reason      string
+	err         error
+}
+
+// updateCheckedMsg carries the result of an update check started from the board
+type updateCheckedMsg struct {
+	newVersion string // empty when gci is up to date or the check failed
+}
+
+// boardUpdateCheck queries GitHub for a newer release, bypassing the update cache.
+// Tests replace it to avoid the network.
+var boardUpdateCheck = func() string {
+	return (<-version.StartFreshUpdateCheck()).NewVersion
+}
+
+// lookPath finds a program on PATH, for enter's claude check. Variable so tests can
+// stub it.
+var lookPath = exec.LookPath
+
+// pullRequestsMsg carries the pull requests linked to an issue (P)
+type pullRequestsMsg struct {
+	key string
+	prs []pullRequest
+	err error
+}
+
+// rawIssueMsg carries an issue's pretty-printed JSON for the raw view
+type rawIssueMsg struct {
+	key  string
+	json string
+	err  error
+}
+
+// columnCheckMsg carries warnings about columns whose status category JIRA doesn't have
+type columnCheckMsg struct {
+	warnings []string
+}
+
+// epicsLoadedMsg carries epic names and colors looked up for board rows
+type epicsLoadedMsg struct {
+	epics map[string]epicInfo
+}
+
+// scopeFetchMsg fires once a scope selection has settled; stale generations are ignored
+type scopeFetchMsg struct {
+	gen   int
+	scope scopeFilter
+}
+
+// scopeFetchDebounce is how long the scope must stay selected before uncached data is fetched
+const scopeFetchDebounce = 300 * time.Millisecond
+
+// expandedExtraLines is the number of rows an expanded issue adds below its line
+const expandedExtraLines = 2
+
+// singleColumnWidth is the terminal width below which the board shows one column at a time
+const singleColumnWidth = 80
+
+// minBoardWidth and minBoardHeight are the smallest terminal the board lays out in;
+// below them View shows a resize hint instead of a broken layout
+const (
+	minBoardWidth  = 30
+	minBoardHeight = 10
+)
+
+// columnResizeStep is how many characters + and - widen or narrow the selected column
+const columnResizeStep = 4
+
+// focusRefreshInterval is how long after a load refresh_on_focus waits before reloading
+// again, so switching between windows quickly doesn't refetch every time
+const focusRefreshInterval = 30 * time.Second
+
 // lazyBatchLoadedMsg contains background-fetched data for a specific scope across columns
 type lazyBatchLoadedMsg struct {
-	scope   scopeFilter
-	byIndex map[int][]JiraIssue // column index -> issues
+	scope    scopeFilter
+	byColumn map[string][]JiraIssue // column title -> issues; titles stay valid if columns move mid-fetch
 }
 
 type boardModel struct {
@@ -48,6 +270,8 @@ type boardModel struct {
 	curScope        scopeFilter
 	width           int
 	height          int
+	singleColumn    bool // terminal narrower than singleColumnWidth: render only the selected column
+	tooSmall        bool // terminal below minBoardWidth x minBoardHeight: View shows a resize hint
 	filtering       bool
 	filterInput     textinput.Model
 	filter          string
@@ -58,8 +282,50 @@ type boardModel struct {
 	pendingWorktree string
 	pendingIssue    JiraIssue
 	pendingClaude   bool // whether to spawn Claude after TUI exits
+	pendingStart    bool // apply on_start_transition to pendingIssue after TUI exits
 	statusMsg       string
 	statusClearAt   time.Time
+	pendingSearch   string               // JQL shown by O, opened in the browser if O is pressed again
+	updateVersion   string               // newer release found by U; shown in an overlay until a key is pressed
+	expandedKey     string               // issue shown with its inline detail rows (space toggles)
+	issueDetails    map[string]JiraIssue // on-demand details by issue key
+	scopeGen        int                  // bumped on every scope change to debounce fetches
+	scopeCancel     context.CancelFunc   // cancels the in-flight scope fetch when superseded
+	hierarchy       hierarchyMode        // subtask display (t cycles)
+	layout          rowLayout            // row density (z cycles)
+	focusMode       bool                 // hide Done and empty columns (F toggles)
+	epics           map[string]epicInfo  // session cache of epic names/colors by key (show_epics)
+	epicSort        bool                 // group issues by epic within each column (E toggles)
+	columnWarnings  []string             // columns matching no status category on the instance
+	loadedAt        time.Time            // when the columns last finished loading
+	rebuilding      bool                 // the load in flight started from empty caches (R)
+	columnWeights   []int                // relative column widths in column order (+/- adjust); nil uses the default split
+	blockedOnly     bool                 // show only flagged or blocked issues (B toggles)
+	preset          string               // active jql_presets name (p cycles); empty uses the scope
+	hidden          map[string]bool      // issues hidden for the session (x); X shows them again
+	prKey           string               // issue whose linked pull requests are listed in an overlay (P)
+	prList          []pullRequest        // pull requests listed for prKey
+	rawJSON         string               // issue JSON shown in the help overlay's place (J); empty shows help
+	creating        bool                 // quick create summary prompt is open (C)
+	treeRoot        string               // when set, columns show only this issue and its descendants (f)
+	createInput     textinput.Model
+	assignKey       string // issue being reassigned; non-empty while the reassign prompt is open (A)
+	assignInput     textinput.Model
+	userMatches     []jiraUser // reassign picker choices; empty while the user is typing a query
+	userCursor      int
+	worklogKey      string // issue work is being logged on; non-empty while the prompt is open (L)
+	worklogSpent    string // validated time spent; set once the prompt moves on to the remaining estimate
+	worklogInput    textinput.Model
+	selecting       bool                 // multi-select mode: space marks issues (v)
+	marked          map[string]JiraIssue // issues marked for a bulk action, by key
+	bulkPrompt      bool                 // bulk transition target prompt is open (T)
+	collision       *branchCollisionPrompt // open while asking about an unrelated existing branch (b, enter, S)
+	bulkInput       textinput.Model
+	bulkTarget      string      // transition or status a bulk run applies
+	bulkQueue       []JiraIssue // marked issues not yet transitioned
+	bulkTotal       int
+	bulkMoved       int
+	bulkProblems    []string // per-issue skips and failures, shown when the run ends
 }
 
 // newBoardStyles returns hardcoded dark theme styles
@@ -76,6 +342,17 @@ func newBoardStyles() boardStyles {
 		helpTitle:   lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("99")),
 		helpKey:     lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("10")),
 		error:       lipgloss.NewStyle().Foreground(lipgloss.Color("1")),
+		blocked:     lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("9")),
+		badges: []lipgloss.Style{
+			lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("0")).Background(lipgloss.Color("39")),
+			lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("0")).Background(lipgloss.Color("42")),
+			lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("0")).Background(lipgloss.Color("214")),
+			lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("0")).Background(lipgloss.Color("170")),
+			lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("0")).Background(lipgloss.Color("81")),
+			lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("0")).Background(lipgloss.Color("203")),
+			lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("0")).Background(lipgloss.Color("185")),
+			lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("0")).Background(lipgloss.Color("141")),
+		},
 	}
 }
 
@@ -91,6 +368,8 @@ type boardStyles struct {
 	helpTitle   lipgloss.Style
 	helpKey     lipgloss.Style
 	error       lipgloss.Style
+	blocked     lipgloss.Style
+	badges      []lipgloss.Style // assignee badge colors, picked per person by badgeStyle
 }
 
 func initialBoardModel(cfg *Config) boardModel {
@@ -98,42 +377,389 @@ func initialBoardModel(cfg *Config) boardModel {
 	ti.Placeholder = "filter..."
 	ti.CharLimit = 256
 
+	ci := textinput.New()
+	ci.Placeholder = "summary..."
+	ci.CharLimit = 255
+
+	ai := textinput.New()
+	ai.Placeholder = "name or email..."
+	ai.CharLimit = 128
+
+	wi := textinput.New()
+	wi.CharLimit = 32
+
+	bi := textinput.New()
+	bi.Placeholder = "status or transition..."
+	bi.CharLimit = 64
+
 	// Initialize hardcoded dark theme styles
 	styles := newBoardStyles()
 
 	// Load UI preferences
 	uiPrefs := usercfg.GetUIPrefs()
 
-	// Determine initial scope
+	// Determine initial scope: a project_scopes entry for a single-project board, else
+	// the last scope used, else default_scope
 	var initialScope scopeFilter
-	if uiPrefs.LastScope != "" {
+	if uiPrefs.LastScope != "" && !cfg.ProjectScope {
 		initialScope = scopeFromString(uiPrefs.LastScope)
 	} else {
-		initialScope = getDefaultScope()
+		initialScope = getDefaultScope(cfg)
 	}
 
-	// Determine initial selected column
-	var initialCol int
-	if uiPrefs.LastSelectedCol >= 0 && uiPrefs.LastSelectedCol < 3 {
-		initialCol = uiPrefs.LastSelectedCol
+	// Apply saved column order; LastSelectedCol is saved relative to this order
+	columns := applyColumnOrder([]kanbanColumnView{
+		{title: "To Do", statusCategory: cfg.statusCategoryName(jira.StatusCategoryNew)},
+		{title: "In Progress", statusCategory: cfg.statusCategoryName(jira.StatusCategoryIndeterminate)},
+		{title: "Done", statusCategory: cfg.statusCategoryName(jira.StatusCategoryDone)},
+	}, uiPrefs.ColumnOrder)
+	if cfg.AllStatuses {
+		columns = applyColumnOrder(append(columns, kanbanColumnView{title: "Other", statusCategory: otherStatusCategory}), uiPrefs.ColumnOrder)
 	}
 
-	return boardModel{
-		cfg: cfg,
-		columns: []kanbanColumnView{
-			{title: "To Do", statusCategory: "To Do"},
-			{title: "In Progress", statusCategory: "In Progress"},
-			{title: "Done", statusCategory: "Done"},
-		},
-		selectedCol: initialCol,
-		loading:     true,
-		curScope:    initialScope,
-		filterInput: ti,
-		styles:      styles,
+	// Saved widths and the selected column only fit the column count they were saved
+	// with (e.g. all_statuses may have been toggled since); Sanitize drops the rest.
+	// Widths are applied as proportions so they scale with the terminal.
+	uiPrefs = uiPrefs.Sanitize(len(columns))
+	columnWeights := uiPrefs.ColumnWidths
+	initialCol := uiPrefs.LastSelectedCol
+
+	m := boardModel{
+		cfg:          cfg,
+		columns:      columns,
+		selectedCol:  initialCol,
+		loading:      true,
+		curScope:     initialScope,
+		filterInput:  ti,
+		createInput:  ci,
+		assignInput:  ai,
+		worklogInput: wi,
+		bulkInput:    bi,
+		styles:       styles,
+		layout:       rowLayoutFromPrefs(uiPrefs),
+		focusMode:    uiPrefs.FocusMode,
+		epics:        make(map[string]epicInfo),
+		hidden:       make(map[string]bool),
+	}
+	m.columnWeights = columnWeights
+	if m.focusMode && m.isDoneColumn(initialCol) {
+		m.selectedCol = m.nextVisibleColumn(1)
+	}
+	return m
+}
+
+// epicLookupCmd fetches epics referenced by loaded issues that aren't cached yet.
+// Pending keys are cached right away (named from the parent summary when known) so
+// overlapping loads don't look them up twice.
+func (m boardModel) epicLookupCmd() tea.Cmd {
+	if !m.cfg.ShowEpics {
+		return nil
+	}
+	var keys []string
+	for _, c := range m.columns {
+		for _, issues := range c.allByScope {
+			for _, it := range issues {
+				key := issueEpicKey(it)
+				if key == "" {
+					continue
+				}
+				if _, ok := m.epics[key]; ok {
+					continue
+				}
+				name := key
+				if it.Fields.Parent.Key == key && it.Fields.Parent.Fields.Summary != "" {
+					name = it.Fields.Parent.Fields.Summary
+				}
+				m.epics[key] = epicInfo{Key: key, Name: name}
+				keys = append(keys, key)
+			}
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	cfg := *m.cfg
+	return func() tea.Msg {
+		return epicsLoadedMsg{epics: fetchEpics(&cfg, keys)}
+	}
+}
+
+// epicTag returns the row's epic label, e.g. "‹Checkout› ", and the epic's color
+func (m boardModel) epicTag(issue JiraIssue) (string, string) {
+	if !m.cfg.ShowEpics {
+		return "", ""
+	}
+	key := issueEpicKey(issue)
+	if key == "" {
+		return "", ""
+	}
+	epic, ok := m.epics[key]
+	if !ok || epic.Name == "" {
+		epic = epicInfo{Key: key, Name: key}
+	}
+	name := []rune(epic.Name)
+	if len(name) > epicTagWidth {
+		name = append(name[:epicTagWidth-1], '…')
+	}
+	return "‹" + string(name) + "› ", epic.Color
+}
+
+// regroupColumns re-derives every column's visible issues after a grouping change
+func (m *boardModel) regroupColumns() {
+	for i := range m.columns {
+		m.columns[i].issues = m.filterAndGroupColumn(m.columns[i].title, m.columns[i].allIssues, m.filter)
+		m.ensureCursorVisible(&m.columns[i])
+	}
+}
+
+// isDoneColumn reports whether column i lists the Done status category
+func (m boardModel) isDoneColumn(i int) bool {
+	return m.columns[i].statusCategory == m.cfg.statusCategoryName(jira.StatusCategoryDone)
+}
+
+// columnVisible reports whether column i is drawn. Focus mode hides Done and columns
+// that loaded empty for the current scope; the selected column always shows.
+func (m boardModel) columnVisible(i int) bool {
+	if !m.focusMode || i == m.selectedCol {
+		return true
+	}
+	if m.isDoneColumn(i) {
+		return false
+	}
+	c := m.columns[i]
+	_, loaded := c.allByScope[m.curScope]
+	return !loaded || len(c.issues) > 0
+}
+
+// nextVisibleColumn returns the first visible column delta steps from the selected
+// one, wrapping around; the selected column if no other is visible
+func (m boardModel) nextVisibleColumn(delta int) int {
+	n := len(m.columns)
+	for step := 1; step < n; step++ {
+		i := ((m.selectedCol+delta*step)%n + n) % n
+		if m.columnVisible(i) {
+			return i
+		}
+	}
+	return m.selectedCol
+}
+
+func (m boardModel) Init() tea.Cmd { return tea.Batch(m.loadDataCmd(), m.checkColumnsCmd()) }
+
+// checkColumnsCmd compares the columns' status categories with the instance's, once per
+// board start. Best-effort: if JIRA can't be asked, no warning is shown.
+func (m boardModel) checkColumnsCmd() tea.Cmd {
+	cfg := *m.cfg
+	columns := make([]kanbanColumnView, len(m.columns))
+	copy(columns, m.columns)
+	return func() tea.Msg {
+		fetched, err := jira.FetchStatusCategories(cfg.JiraURL, cfg.Email, cfg.APIToken)
+		if err != nil || len(fetched) == 0 {
+			return columnCheckMsg{}
+		}
+		return columnCheckMsg{warnings: columnCategoryWarnings(columns, fetched)}
+	}
+}
+
+// columnCategoryWarnings describes each column whose status category is not one of the
+// instance's category names; such a column always stays empty
+func columnCategoryWarnings(columns []kanbanColumnView, instance map[string]string) []string {
+	known := make(map[string]bool, len(instance))
+	names := make([]string, 0, len(instance))
+	for _, key := range []string{jira.StatusCategoryNew, jira.StatusCategoryIndeterminate, jira.StatusCategoryDone} {
+		if name := instance[key]; name != "" {
+			known[strings.ToLower(name)] = true
+			names = append(names, name)
+		}
+	}
+	var warnings []string
+	for _, c := range columns {
+		if c.statusCategory == otherStatusCategory || known[strings.ToLower(c.statusCategory)] {
+			continue
+		}
+		warnings = append(warnings, fmt.Sprintf("%s column: JIRA has no status category %q (it has %s); fix [status_categories] in your config",
+			c.title, c.statusCategory, strings.Join(names, ", ")))
+	}
+	return warnings
+}
+
+// applyColumnOrder returns columns reordered to match titles. Columns not named in
+// titles keep their relative order after the named ones; unknown titles are ignored.
+func applyColumnOrder(columns []kanbanColumnView, titles []string) []kanbanColumnView {
+	ordered := make([]kanbanColumnView, 0, len(columns))
+	used := make([]bool, len(columns))
+	for _, title := range titles {
+		for i, c := range columns {
+			if !used[i] && c.title == title {
+				ordered = append(ordered, c)
+				used[i] = true
+				break
+			}
+		}
+	}
+	for i, c := range columns {
+		if !used[i] {
+			ordered = append(ordered, c)
+		}
+	}
+	return ordered
+}
+
+// cursorKeys returns the issue under each column's cursor, by column title
+func (m boardModel) cursorKeys() map[string]string {
+	keys := make(map[string]string, len(m.columns))
+	for _, c := range m.columns {
+		if c.cursor < len(c.issues) {
+			keys[c.title] = c.issues[c.cursor].Key
+		}
+	}
+	return keys
+}
+
+// restoreCursors puts each column's cursor back on the issue it was on before a reload
+// when that issue is still listed; otherwise the cursor keeps its position
+func restoreCursors(columns []kanbanColumnView, keys map[string]string) {
+	for i := range columns {
+		key, ok := keys[columns[i].title]
+		if !ok {
+			continue
+		}
+		for j, issue := range columns[i].issues {
+			if issue.Key == key {
+				columns[i].cursor = j
+				break
+			}
+		}
+	}
+}
+
+// columnTitles returns the column titles in display order
+func (m boardModel) columnTitles() []string {
+	titles := make([]string, len(m.columns))
+	for i, c := range m.columns {
+		titles[i] = c.title
+	}
+	return titles
+}
+
+// columnIndex returns the display index of the column with the given title, or -1
+func (m boardModel) columnIndex(title string) int {
+	for i, c := range m.columns {
+		if c.title == title {
+			return i
+		}
+	}
+	return -1
+}
+
+// columnWidths returns each column's rendered width. Visible columns split the
+// terminal by columnWeights when set (+/- adjust them), else To Do 35%, In Progress
+// 35%, Done 30%, with extra columns split evenly. Narrow terminals get the selected
+// column alone at full width instead.
+func (m boardModel) columnWidths(visible []int) []int {
+	colWidths := make([]int, len(m.columns))
+	if m.singleColumn {
+		for i := range colWidths {
+			colWidths[i] = max(16, m.width-2) // border only; padding is inside the width
+		}
+		return colWidths
+	}
+
+	// Leave some margin for borders/padding
+	usableWidth := m.width - 6 // account for borders and spacing
+	if len(m.columnWeights) == len(m.columns) {
+		total := 0
+		for _, i := range visible {
+			total += m.columnWeights[i]
+		}
+		for _, i := range visible {
+			colWidths[i] = usableWidth * m.columnWeights[i] / max(1, total)
+		}
+	} else {
+		shares := []float64{0.35, 0.35, 0.30} // To Do, In Progress, Done
+		for pos, i := range visible {
+			if len(visible) == 3 {
+				colWidths[i] = int(float64(usableWidth) * shares[pos])
+			} else {
+				colWidths[i] = usableWidth / len(visible)
+			}
+		}
+	}
+	// Ensure minimum widths
+	for i := range colWidths {
+		colWidths[i] = max(16, colWidths[i])
+	}
+	return colWidths
+}
+
+// allColumns returns every column index, for layouts that ignore focus mode
+func (m boardModel) allColumns() []int {
+	all := make([]int, len(m.columns))
+	for i := range all {
+		all[i] = i
+	}
+	return all
+}
+
+// resizeSelectedColumn widens (delta > 0) or narrows the selected column by delta
+// characters, taking the space from or giving it to the other visible columns in
+// proportion. Returns false when a column would drop below the minimum width.
+func (m *boardModel) resizeSelectedColumn(delta int) bool {
+	var visible []int
+	for i := range m.columns {
+		if m.columnVisible(i) {
+			visible = append(visible, i)
+		}
+	}
+	if m.singleColumn || len(visible) < 2 {
+		return false
+	}
+	// Hidden columns keep their weight; without weights yet, start from the default split
+	weights := m.columnWeights
+	if len(weights) != len(m.columns) {
+		weights = m.columnWidths(m.allColumns())
+	}
+	widths := m.columnWidths(visible)
+	for i := range widths {
+		if !m.columnVisible(i) {
+			widths[i] = weights[i]
+		}
+	}
+	widths[m.selectedCol] += delta
+	if widths[m.selectedCol] < 16 {
+		return false
+	}
+	others := 0
+	for _, i := range visible {
+		if i != m.selectedCol {
+			others += widths[i]
+		}
 	}
+	for _, i := range visible {
+		if i != m.selectedCol {
+			widths[i] -= delta * widths[i] / others
+			if widths[i] < 16 {
+				return false
+			}
+		}
+	}
+	m.columnWeights = widths
+	return true
 }
 
-func (m boardModel) Init() tea.Cmd { return m.loadDataCmd() }
+// moveSelectedColumn swaps the selected column with its neighbor (delta -1 left, +1 right)
+// and keeps the selection on the moved column
+func (m *boardModel) moveSelectedColumn(delta int) {
+	target := m.selectedCol + delta
+	if target < 0 || target >= len(m.columns) {
+		return
+	}
+	m.columns[m.selectedCol], m.columns[target] = m.columns[target], m.columns[m.selectedCol]
+	if len(m.columnWeights) == len(m.columns) {
+		m.columnWeights[m.selectedCol], m.columnWeights[target] = m.columnWeights[target], m.columnWeights[m.selectedCol]
+	}
+	m.selectedCol = target
+}
 
 func (m boardModel) loadDataCmd() tea.Cmd {
 	cfg := *m.cfg
@@ -148,10 +774,26 @@ func (m boardModel) loadDataCmd() tea.Cmd {
 	}
 }
 
+// Default limits on a board load: the visible columns get longer than background scope
+// prefetches. board_load_timeout_seconds replaces both.
+const (
+	defaultBoardLoadTimeout = 30 * time.Second
+	defaultScopeLoadTimeout = 20 * time.Second
+)
+
+// loadTimeout returns the configured board load timeout, or def when none is set;
+// --timeout replaces either
+func loadTimeout(cfg Config, def time.Duration) time.Duration {
+	if cfg.BoardLoadTimeout > 0 {
+		return httputil.Timeout(cfg.BoardLoadTimeout)
+	}
+	return httputil.Timeout(def)
+}
+
 // loadColumnsConcurrently fetches column data concurrently with proper worker limits and context
 func (m boardModel) loadColumnsConcurrently(cfg Config, columns []kanbanColumnView, scope scopeFilter, filter string) tea.Msg {
 	// Create context with timeout for all operations
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), loadTimeout(cfg, defaultBoardLoadTimeout))
 	defer cancel()
 
 	// Use worker pool to limit concurrent requests
@@ -189,6 +831,7 @@ func (m boardModel) loadColumnsConcurrently(cfg Config, columns []kanbanColumnVi
 	}
 	
 	// Collect results with timeout
+	byColumn := make(map[string][]JiraIssue, len(columns))
 collectLoop:
 	for completed := 0; completed < len(columns); completed++ {
 		select {
@@ -201,29 +844,41 @@ collectLoop:
 				return errMsg{result.err}
 			}
 			
-			idx := result.index
-			issues := result.issues
-			
-			columns[idx].allIssues = issues
-			if columns[idx].allByScope == nil {
-				columns[idx].allByScope = make(map[scopeFilter][]JiraIssue)
-			}
-			columns[idx].allByScope[scope] = issues
-			columns[idx].issues = m.filterAndGroupColumn(columns[idx].title, issues, filter)
-
-			if columns[idx].cursor >= len(issues) {
-				if len(issues) == 0 {
-					columns[idx].cursor = 0
-				} else {
-					columns[idx].cursor = len(issues) - 1
-				}
-			}
+			byColumn[columns[result.index].title] = result.issues
 			
 		case <-ctx.Done():
 			// Timeout - return partial results
 			break collectLoop
 		}
 	}
+
+	// Place issues only once every column is in, since overrides move them between columns
+	for title, issues := range applyStatusOverrides(byColumn, cfg.ColumnOverrides) {
+		idx := -1
+		for i := range columns {
+			if columns[i].title == title {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			continue
+		}
+		columns[idx].allIssues = issues
+		if columns[idx].allByScope == nil {
+			columns[idx].allByScope = make(map[scopeFilter][]JiraIssue)
+		}
+		columns[idx].allByScope[scope] = issues
+		columns[idx].issues = m.filterAndGroupColumn(columns[idx].title, issues, filter)
+
+		if columns[idx].cursor >= len(issues) {
+			if len(issues) == 0 {
+				columns[idx].cursor = 0
+			} else {
+				columns[idx].cursor = len(issues) - 1
+			}
+		}
+	}
 	
 	return dataLoadedMsg{columns: columns}
 }
@@ -231,7 +886,7 @@ collectLoop:
 // loadScopeConcurrently loads a specific scope across all columns concurrently for background caching
 func (m boardModel) loadScopeConcurrently(cfg Config, columns []kanbanColumnView, scope scopeFilter) lazyBatchLoadedMsg {
 	// Create context with timeout for all operations  
-	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), loadTimeout(cfg, defaultScopeLoadTimeout))
 	defer cancel()
 
 	// Use worker pool to limit concurrent requests
@@ -269,7 +924,7 @@ func (m boardModel) loadScopeConcurrently(cfg Config, columns []kanbanColumnView
 	}
 	
 	// Collect results with timeout
-	byIdx := make(map[int][]JiraIssue, len(columns))
+	byColumn := make(map[string][]JiraIssue, len(columns))
 	
 collectScopeLoop:	
 	for completed := 0; completed < len(columns); completed++ {
@@ -280,7 +935,7 @@ collectScopeLoop:
 				continue
 			}
 			
-			byIdx[result.index] = result.issues
+			byColumn[columns[result.index].title] = result.issues
 			
 		case <-ctx.Done():
 			// Timeout - return partial results
@@ -288,53 +943,343 @@ collectScopeLoop:
 		}
 	}
 	
-	return lazyBatchLoadedMsg{scope: scope, byIndex: byIdx}
+	return lazyBatchLoadedMsg{scope: scope, byColumn: applyStatusOverrides(byColumn, cfg.ColumnOverrides)}
+}
+
+// boardColumnTitles are the board's columns; Other only shows with all_statuses
+var boardColumnTitles = []string{"To Do", "In Progress", "Done", "Other"}
+
+// boardColumnTitle returns the column title matching name case-insensitively, or ""
+func boardColumnTitle(name string) string {
+	for _, title := range boardColumnTitles {
+		if strings.EqualFold(title, name) {
+			return title
+		}
+	}
+	return ""
+}
+
+// applyStatusOverrides moves issues whose status is listed in status_column_overrides
+// (status name -> column title, both case-insensitive) into that column, keeping the
+// target column's order with moved issues after its own. Issues stay put when the
+// target column isn't among those fetched, so nothing drops off the board.
+func applyStatusOverrides(byColumn map[string][]JiraIssue, overrides map[string]string) map[string][]JiraIssue {
+	if len(overrides) == 0 {
+		return byColumn
+	}
+	targets := make(map[string]string, len(overrides)) // lowercased status -> fetched column title
+	for status, column := range overrides {
+		for title := range byColumn {
+			if strings.EqualFold(title, column) {
+				targets[strings.ToLower(status)] = title
+			}
+		}
+	}
+	titles := make([]string, 0, len(byColumn))
+	for title := range byColumn {
+		titles = append(titles, title)
+	}
+	sort.Strings(titles) // moved issues land in a stable order
+	out := make(map[string][]JiraIssue, len(byColumn))
+	var moved []JiraIssue
+	for _, title := range titles {
+		issues := byColumn[title]
+		kept := make([]JiraIssue, 0, len(issues))
+		for _, it := range issues {
+			if target, ok := targets[strings.ToLower(it.Fields.Status.Name)]; ok && target != title {
+				moved = append(moved, it)
+				continue
+			}
+			kept = append(kept, it)
+		}
+		out[title] = kept
+	}
+	for _, it := range moved {
+		target := targets[strings.ToLower(it.Fields.Status.Name)]
+		out[target] = append(out[target], it)
+	}
+	return out
 }
 
 // filterAndGroupColumn applies a fuzzy text filter and then
 // groups/partitions issues for display.
 func (m boardModel) filterAndGroupColumn(title string, all []JiraIssue, filter string) []JiraIssue {
+	all = m.restrictToTree(all)
+	all = m.withoutHidden(all)
+	if m.blockedOnly {
+		all = blockedIssues(all)
+	}
+	priorities, filter := splitPriorityFilter(filter)
+	if len(priorities) > 0 {
+		all = withPriority(all, priorities)
+	}
 	if filter == "" {
-		return reorderAndGroupIssues(title, all)
+		if m.epicSort {
+			all = sortByEpic(all, m.epics)
+		}
+		return m.arrangeHierarchy(title, all)
 	}
 
 	normalizedFilter := usercfg.NormalizeSearchText(filter)
 
 	type scoredIssue struct {
-		issue JiraIssue
-		score int
+		issue     JiraIssue
+		score     float64
+		keyPrefix bool
 	}
 	var scored []scoredIssue
 	for _, it := range all {
 		keyScore := usercfg.FuzzyScore(normalizedFilter, usercfg.NormalizeSearchText(it.Key))
 		summaryScore := usercfg.FuzzyScore(normalizedFilter, usercfg.NormalizeSearchText(it.Fields.Summary))
-		bestScore := keyScore
-		if summaryScore > bestScore {
-			bestScore = summaryScore
-		}
-		if bestScore > 0 {
-			scored = append(scored, scoredIssue{issue: it, score: bestScore})
+		if keyScore <= 0 && summaryScore <= 0 {
+			continue
 		}
+		// Weights only rank matches; they never drop one
+		bestScore := max(float64(keyScore)*filterWeight(m.cfg.FilterKeyWeight), float64(summaryScore)*filterWeight(m.cfg.FilterSummaryWeight))
+		scored = append(scored, scoredIssue{
+			issue:     it,
+			score:     bestScore,
+			keyPrefix: usercfg.KeyPrefixMatch(normalizedFilter, it.Key),
+		})
 	}
-	// Sort by score (highest first)
-	for i := 0; i < len(scored)-1; i++ {
-		for j := i + 1; j < len(scored); j++ {
-			if scored[j].score > scored[i].score {
-				scored[i], scored[j] = scored[j], scored[i]
-			}
+	// Key-prefix matches first, then by score (highest first); ties keep JIRA's order
+	sort.SliceStable(scored, func(i, j int) bool {
+		if scored[i].keyPrefix != scored[j].keyPrefix {
+			return scored[i].keyPrefix
 		}
-	}
+		return scored[i].score > scored[j].score
+	})
 	result := make([]JiraIssue, len(scored))
 	for i, s := range scored {
 		result[i] = s.issue
 	}
-	return reorderAndGroupIssues(title, result)
+	return m.arrangeHierarchy(title, result)
+}
+
+// treeMembers returns the keys of treeRoot and every issue descending from it (subtasks,
+// or children of an epic) across all columns
+func (m boardModel) treeMembers() map[string]struct{} {
+	members := map[string]struct{}{m.treeRoot: {}}
+	for added := true; added; {
+		added = false
+		for _, c := range m.columns {
+			for _, it := range c.allIssues {
+				if _, ok := members[it.Key]; ok || it.Fields.Parent.Key == "" {
+					continue
+				}
+				if _, ok := members[it.Fields.Parent.Key]; ok {
+					members[it.Key] = struct{}{}
+					added = true
+				}
+			}
+		}
+	}
+	return members
+}
+
+// defaultIssueTypeMarkers prefix board rows by issue type (lowercased); types not
+// listed get no marker
+var defaultIssueTypeMarkers = map[string]string{
+	"bug":         "🐛",
+	"story":       "📖",
+	"task":        "✔",
+	"sub-task":    "↳",
+	"subtask":     "↳",
+	"epic":        "⚡",
+	"spike":       "🔎",
+	"improvement": "⬆",
+}
+
+// asciiIssueTypeMarkers replace the defaults on terminals that can't show emoji
+// (NO_COLOR set or TERM=dumb)
+var asciiIssueTypeMarkers = map[string]string{
+	"bug":         "[B]",
+	"story":       "[S]",
+	"task":        "[T]",
+	"sub-task":    "[ST]",
+	"subtask":     "[ST]",
+	"epic":        "[E]",
+	"spike":       "[SP]",
+	"improvement": "[I]",
+}
+
+// issueTypeMarkers merges issue_type_markers over the default markers, keyed by
+// lowercased type name. An empty marker turns a type's marker off.
+func issueTypeMarkers(custom map[string]string) map[string]string {
+	defaults := defaultIssueTypeMarkers
+	if os.Getenv("NO_COLOR") != "" || os.Getenv("TERM") == "dumb" {
+		defaults = asciiIssueTypeMarkers
+	}
+	markers := make(map[string]string, len(defaults)+len(custom))
+	for issueType, marker := range defaults {
+		markers[issueType] = marker
+	}
+	for issueType, marker := range custom {
+		markers[strings.ToLower(issueType)] = marker
+	}
+	return markers
+}
+
+// typeMarker returns the marker (with a trailing space) shown before an issue's key
+func (m boardModel) typeMarker(issue JiraIssue) string {
+	if marker := m.cfg.IssueTypeMarkers[strings.ToLower(issue.Fields.IssueType.Name)]; marker != "" {
+		return marker + " "
+	}
+	return ""
+}
+
+// assigneeInitials returns a two-letter badge for a display name: the first and last
+// names' initials, or the first two letters of a single name
+func assigneeInitials(name string) string {
+	parts := strings.Fields(name)
+	if len(parts) == 0 {
+		return "--"
+	}
+	first := []rune(parts[0])
+	if len(parts) == 1 {
+		if len(first) == 1 {
+			return strings.ToUpper(string(first))
+		}
+		return strings.ToUpper(string(first[:2]))
+	}
+	last := []rune(parts[len(parts)-1])
+	return strings.ToUpper(string(first[0]) + string(last[0]))
+}
+
+// badgeStyle picks an assignee's badge color by hashing their account id (display
+// name if there's none), so each person keeps one color across columns and sessions
+func (m boardModel) badgeStyle(issue JiraIssue) lipgloss.Style {
+	id := issue.Fields.Assignee.AccountID
+	if id == "" {
+		id = issue.Fields.Assignee.DisplayName
+	}
+	h := fnv.New32a()
+	h.Write([]byte(id))
+	return m.styles.badges[h.Sum32()%uint32(len(m.styles.badges))]
+}
+
+// isBlockedIssue reports whether an issue is flagged as an impediment or sits in a
+// "Blocked" status
+func isBlockedIssue(issue JiraIssue) bool {
+	return issue.Flagged || strings.Contains(strings.ToLower(issue.Fields.Status.Name), "blocked")
+}
+
+// blockedIssues keeps the flagged or blocked issues
+func blockedIssues(issues []JiraIssue) []JiraIssue {
+	out := make([]JiraIssue, 0, len(issues))
+	for _, it := range issues {
+		if isBlockedIssue(it) {
+			out = append(out, it)
+		}
+	}
+	return out
+}
+
+// splitPriorityFilter separates priority:NAME terms (NAME may list several, comma
+// separated) from the board filter, returning the lowercased priorities and the rest
+// of the filter as text to match
+func splitPriorityFilter(filter string) ([]string, string) {
+	if !strings.Contains(strings.ToLower(filter), "priority:") {
+		return nil, filter
+	}
+	var priorities, rest []string
+	for _, term := range strings.Fields(filter) {
+		if len(term) < len("priority:") || !strings.EqualFold(term[:len("priority:")], "priority:") {
+			rest = append(rest, term)
+			continue
+		}
+		for _, name := range strings.Split(term[len("priority:"):], ",") {
+			if name != "" {
+				priorities = append(priorities, strings.ToLower(name))
+			}
+		}
+	}
+	return priorities, strings.Join(rest, " ")
+}
+
+// withPriority keeps the issues whose priority is one of priorities (lowercased)
+func withPriority(issues []JiraIssue, priorities []string) []JiraIssue {
+	wanted := make(map[string]bool, len(priorities))
+	for _, p := range priorities {
+		wanted[p] = true
+	}
+	out := make([]JiraIssue, 0, len(issues))
+	for _, it := range issues {
+		if wanted[strings.ToLower(it.Fields.Priority.Name)] {
+			out = append(out, it)
+		}
+	}
+	return out
+}
+
+// nextPreset returns the preset name after current in alphabetical order, or "" (the
+// scope) after the last one
+func nextPreset(presets map[string]string, current string) string {
+	names := make([]string, 0, len(presets))
+	for name := range presets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if current == "" || name > current {
+			return name
+		}
+	}
+	return ""
+}
+
+// withoutHidden drops issues hidden for the session with x
+func (m boardModel) withoutHidden(issues []JiraIssue) []JiraIssue {
+	if len(m.hidden) == 0 {
+		return issues
+	}
+	out := make([]JiraIssue, 0, len(issues))
+	for _, it := range issues {
+		if !m.hidden[it.Key] {
+			out = append(out, it)
+		}
+	}
+	return out
+}
+
+// restrictToTree drops issues outside the tree filter, if one is active
+func (m boardModel) restrictToTree(issues []JiraIssue) []JiraIssue {
+	if m.treeRoot == "" {
+		return issues
+	}
+	members := m.treeMembers()
+	out := make([]JiraIssue, 0, len(issues))
+	for _, it := range issues {
+		if _, ok := members[it.Key]; ok {
+			out = append(out, it)
+		}
+	}
+	return out
+}
+
+// arrangeHierarchy orders issues according to the current hierarchy mode
+func (m boardModel) arrangeHierarchy(title string, issues []JiraIssue) []JiraIssue {
+	switch m.hierarchy {
+	case hierarchyFlat:
+		return issues
+	case hierarchyParentsOnly:
+		grouped := reorderAndGroupIssues(issues, separatesBacklog(m.cfg.SeparateBacklog, title))
+		out := make([]JiraIssue, 0, len(grouped))
+		for _, it := range grouped {
+			if !it.Fields.IssueType.Subtask {
+				out = append(out, it)
+			}
+		}
+		return out
+	default:
+		return reorderAndGroupIssues(issues, separatesBacklog(m.cfg.SeparateBacklog, title))
+	}
 }
 
 // reorderAndGroupIssues returns a new slice where parent issues appear before their subtasks,
 // and for To Do columns with mixed backlog/active statuses: non-backlog items (incl. promoted backlog parents of To Do subtasks)
 // come before backlog items. Order is otherwise stable.
-func reorderAndGroupIssues(columnTitle string, issues []JiraIssue) []JiraIssue {
+func reorderAndGroupIssues(issues []JiraIssue, separateBacklog bool) []JiraIssue {
 	if len(issues) == 0 {
 		return issues
 	}
@@ -346,15 +1291,12 @@ func reorderAndGroupIssues(columnTitle string, issues []JiraIssue) []JiraIssue {
 		present[it.Key] = struct{}{}
 	}
 
-	isBacklog := func(it JiraIssue) bool {
-		return strings.Contains(strings.ToLower(it.Fields.Status.Name), "backlog")
-	}
-	// Partition top vs backlog for To Do column
+	// Partition top vs backlog when this column separates them
 	topSet := make(map[string]struct{}, len(issues))
 	backlogSet := make(map[string]struct{}, len(issues))
-	if columnTitle == "To Do" {
+	if separateBacklog {
 		for _, it := range issues {
-			if isBacklog(it) {
+			if isBacklogIssue(it) {
 				backlogSet[it.Key] = struct{}{}
 			} else {
 				topSet[it.Key] = struct{}{}
@@ -373,7 +1315,7 @@ func reorderAndGroupIssues(columnTitle string, issues []JiraIssue) []JiraIssue {
 			}
 		}
 	} else {
-		// Not the To Do column: keep everything in topSet to preserve original order
+		// Not separating: keep everything in topSet to preserve original order
 		for _, it := range issues {
 			topSet[it.Key] = struct{}{}
 		}
@@ -422,8 +1364,8 @@ func reorderAndGroupIssues(columnTitle string, issues []JiraIssue) []JiraIssue {
 		}
 	}
 
-	// Second pass: backlog group (for To Do only)
-	if columnTitle == "To Do" {
+	// Second pass: backlog group
+	if separateBacklog {
 		for _, it := range issues {
 			if _, ok := backlogSet[it.Key]; !ok {
 				continue
@@ -456,11 +1398,22 @@ func (m boardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
+		m.singleColumn = msg.Width < singleColumnWidth
+		m.tooSmall = msg.Width < minBoardWidth || msg.Height < minBoardHeight
 		// Keep cursor visible in each column after resize
 		for i := range m.columns {
 			m.ensureCursorVisible(&m.columns[i])
 		}
 		return m, nil
+	case tea.FocusMsg:
+		if !m.cfg.RefreshOnFocus || m.loading || time.Since(m.loadedAt) < focusRefreshInterval {
+			return m, nil
+		}
+		m.loading = true
+		if m.cfg.UnhideOnRefresh {
+			clear(m.hidden)
+		}
+		return m, m.loadDataCmd()
 	case tea.KeyMsg:
 		if m.showingHelp {
 			key := msg.String()
@@ -471,8 +1424,9 @@ func (m boardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				maxOffset = len(lines) - viewport
 			}
 			switch key {
-			case "q", "?", "esc":
+			case "q", "?", "esc", "J":
 				m.showingHelp = false
+				m.rawJSON = ""
 				return m, nil
 			case "up", "k":
 				if m.helpOffset > 0 {
@@ -502,6 +1456,50 @@ func (m boardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, nil
 			}
 		}
+		if m.prKey != "" {
+			return m.updatePullRequestPicker(msg)
+		}
+		if m.updateVersion != "" {
+			// any key dismisses the update overlay
+			m.updateVersion = ""
+			return m, nil
+		}
+		if m.creating {
+			switch msg.Type {
+			case tea.KeyEsc, tea.KeyCtrlC:
+				m.creating = false
+				return m, nil
+			case tea.KeyEnter:
+				m.creating = false
+				summary := strings.TrimSpace(m.createInput.Value())
+				if summary == "" {
+					return m, nil
+				}
+				m.statusMsg = "Creating issue..."
+				m.statusClearAt = time.Now().Add(30 * time.Second)
+				cfg := *m.cfg
+				return m, func() tea.Msg {
+					issue, err := quickCreateIssue(&cfg, summary)
+					return issueCreatedMsg{issue: issue, err: err}
+				}
+			default:
+				var cmd tea.Cmd
+				m.createInput, cmd = m.createInput.Update(msg)
+				return m, cmd
+			}
+		}
+		if m.assignKey != "" {
+			return m.updateReassign(msg)
+		}
+		if m.worklogKey != "" {
+			return m.updateWorklog(msg)
+		}
+		if m.bulkPrompt {
+			return m.updateBulkPrompt(msg)
+		}
+		if m.collision != nil {
+			return m.updateCollisionPrompt(msg)
+		}
 		if m.filtering {
 			switch msg.Type {
 			case tea.KeyEsc, tea.KeyCtrlC:
@@ -532,46 +1530,136 @@ func (m boardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case key == "?":
 			m.showingHelp = !m.showingHelp
 			return m, nil
+		case key == "U":
+			m.statusMsg = "Checking for updates..."
+			m.statusClearAt = time.Now().Add(30 * time.Second)
+			return m, func() tea.Msg {
+				return updateCheckedMsg{newVersion: boardUpdateCheck()}
+			}
 		case key == "w":
 			// Mark to launch setup wizard after exiting TUI
 			m.launchSetup = true
 			m.saveUIPreferences()
 			return m, tea.Quit
+		case key == "s" && m.preset != "":
+			m.statusMsg = fmt.Sprintf("Preset %s replaces the scope (p to cycle presets off)", m.preset)
+			m.statusClearAt = time.Now().Add(3 * time.Second)
+			return m, tea.Tick(3*time.Second, func(t time.Time) tea.Msg {
+				return clearStatusMsg{}
+			})
+		case key == "p":
+			if len(m.cfg.JQLPresets) == 0 {
+				m.statusMsg = "No JQL presets configured (add [jql_presets] to the config file)"
+				m.statusClearAt = time.Now().Add(3 * time.Second)
+				return m, tea.Tick(3*time.Second, func(t time.Time) tea.Msg {
+					return clearStatusMsg{}
+				})
+			}
+			m.preset = nextPreset(m.cfg.JQLPresets, m.preset)
+			m.cfg.PresetJQL = m.cfg.JQLPresets[m.preset]
+			// Cached scopes hold the previous source's issues, so drop them and reload
+			m.scopeGen++
+			if m.scopeCancel != nil {
+				m.scopeCancel()
+				m.scopeCancel = nil
+			}
+			for i := range m.columns {
+				m.columns[i].allByScope = nil
+			}
+			m.loading = true
+			return m, m.loadDataCmd()
 		case key == "s":
-			// cycle through 4 scopes; switch instantly if cached, else show per-column loading and fetch in background
+			// cycle through 4 scopes; switch instantly if cached, else show per-column loading and
+			// fetch once the selection settles so fast cycling doesn't fire a request per press
 			m.curScope = (m.curScope + 1) % 4
-			var missing []int
+			m.scopeGen++
+			if m.scopeCancel != nil {
+				m.scopeCancel()
+				m.scopeCancel = nil
+			}
+			missing := false
 			for i := range m.columns {
 				if data, ok := m.columns[i].allByScope[m.curScope]; ok {
 					m.columns[i].allIssues = data
 					m.columns[i].issues = m.filterAndGroupColumn(m.columns[i].title, data, m.filter)
 				} else {
-					missing = append(missing, i)
+					// show a temporary empty list with a loading indicator in View
+					m.columns[i].issues = nil
+					missing = true
 				}
 				m.ensureCursorVisible(&m.columns[i])
 			}
-			if len(missing) == 0 {
+			if !missing {
 				return m, nil
 			}
-			sc := m.curScope
-			cfg := *m.cfg
-			colsSnapshot := make([]kanbanColumnView, len(m.columns))
-			copy(colsSnapshot, m.columns)
-			// mark columns as loading
-			for _, i := range missing {
-				// show a temporary empty list with a loading indicator in View
-				m.columns[i].issues = nil
+			msg := scopeFetchMsg{gen: m.scopeGen, scope: m.curScope}
+			return m, tea.Tick(scopeFetchDebounce, func(t time.Time) tea.Msg {
+				return msg
+			})
+		case key == "v":
+			// Select mode: space marks issues for a bulk action; leaving drops the marks
+			m.selecting = !m.selecting
+			m.marked = nil
+			if m.selecting {
+				m.marked = make(map[string]JiraIssue)
+			}
+			return m, nil
+		case key == "esc" && m.selecting:
+			m.selecting = false
+			m.marked = nil
+			return m, nil
+		case key == " " && m.selecting:
+			// Mark/unmark and move on, so runs of issues are quick to mark
+			issue, ok := m.currentIssue()
+			if !ok {
+				return m, nil
+			}
+			if _, marked := m.marked[issue.Key]; marked {
+				delete(m.marked, issue.Key)
+			} else {
+				m.marked[issue.Key] = issue
+			}
+			col := &m.columns[m.selectedCol]
+			if col.cursor < len(col.issues)-1 {
+				col.cursor++
+				m.ensureCursorVisible(col)
+			}
+			return m, nil
+		case key == "T":
+			if len(m.marked) == 0 {
+				m.statusMsg = "Nothing marked: press v, then space to mark issues"
+				m.statusClearAt = time.Now().Add(3 * time.Second)
+				return m, tea.Tick(3*time.Second, func(t time.Time) tea.Msg {
+					return clearStatusMsg{}
+				})
+			}
+			m.bulkPrompt = true
+			m.bulkInput.SetValue("")
+			m.bulkInput.Focus()
+			return m, nil
+		case key == " ":
+			// Toggle inline details for the selected issue; any press collapses an open one
+			if m.expandedKey != "" {
+				m.expandedKey = ""
+				m.ensureCursorVisible(&m.columns[m.selectedCol])
+				return m, nil
+			}
+			issue, ok := m.currentIssue()
+			if !ok {
+				return m, nil
+			}
+			m.expandedKey = issue.Key
+			m.ensureCursorVisible(&m.columns[m.selectedCol])
+			if _, cached := m.issueDetails[issue.Key]; cached {
+				return m, nil
 			}
+			cfg := *m.cfg
 			return m, func() tea.Msg {
-				byIdx := make(map[int][]JiraIssue, len(colsSnapshot))
-				for i := range colsSnapshot {
-					issues, err := fetchColumnIssues(&cfg, colsSnapshot[i].statusCategory, sc, 100)
-					if err != nil {
-						continue
-					}
-					byIdx[i] = issues
+				details, err := fetchIssueDetails(&cfg, issue.Key)
+				if err != nil {
+					return issueDetailsLoadedMsg{issue: issue, err: err}
 				}
-				return lazyBatchLoadedMsg{scope: sc, byIndex: byIdx}
+				return issueDetailsLoadedMsg{issue: details}
 			}
 		case key == "/":
 			m.filtering = true
@@ -582,18 +1670,225 @@ func (m boardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if issue, ok := m.currentIssue(); ok {
 				_ = openIssueInBrowser(m.cfg, issue)
 			}
-		case key == "c":
-			if issue, ok := m.currentIssue(); ok {
-				if err := clipboard.WriteAll(issue.Key); err != nil {
-					m.statusMsg = "Copy failed: " + err.Error()
-				} else {
-					m.statusMsg = "Copied " + issue.Key
+		case key == "O":
+			// First press shows the query; a second press while it's shown opens it
+			jql := boardSearchJQL(m.cfg, m.curScope, m.filter)
+			if m.pendingSearch == jql && m.statusMsg != "" {
+				m.pendingSearch = ""
+				m.statusMsg = "Opened JIRA search"
+				if err := openSearchInBrowser(m.cfg, jql); err != nil {
+					m.statusMsg = "Open failed: " + err.Error()
 				}
 				m.statusClearAt = time.Now().Add(2 * time.Second)
 				return m, tea.Tick(2*time.Second, func(t time.Time) tea.Msg {
 					return clearStatusMsg{}
 				})
 			}
+			m.pendingSearch = jql
+			m.statusMsg = "JQL: " + jql + " (O again to open in JIRA)"
+			m.statusClearAt = time.Now().Add(5 * time.Second)
+			return m, tea.Tick(5*time.Second, func(t time.Time) tea.Msg {
+				return clearStatusMsg{}
+			})
+		case key == "c":
+			if issue, ok := m.currentIssue(); ok {
+				cmd := m.copyToClipboard(issue.Key, "Copied "+issue.Key)
+				return m, cmd
+			}
+		case key == "Y":
+			keys := m.visibleIssueKeys()
+			if len(keys) == 0 {
+				return m, nil
+			}
+			cmd := m.copyToClipboard(strings.Join(keys, "\n"), fmt.Sprintf("Copied %d issue keys", len(keys)))
+			return m, cmd
+		case key == "P":
+			issue, ok := m.currentIssue()
+			if !ok {
+				return m, nil
+			}
+			if issue.ID == "" {
+				m.statusMsg = "No issue id for " + issue.Key + "; press r to refresh"
+				m.statusClearAt = time.Now().Add(3 * time.Second)
+				return m, tea.Tick(3*time.Second, func(t time.Time) tea.Msg {
+					return clearStatusMsg{}
+				})
+			}
+			m.statusMsg = "Looking up pull requests for " + issue.Key + "..."
+			m.statusClearAt = time.Now().Add(30 * time.Second)
+			cfg := *m.cfg
+			return m, func() tea.Msg {
+				prs, err := fetchLinkedPullRequests(&cfg, issue.ID)
+				return pullRequestsMsg{key: issue.Key, prs: prs, err: err}
+			}
+		case key == "J":
+			issue, ok := m.currentIssue()
+			if !ok {
+				return m, nil
+			}
+			m.statusMsg = "Fetching " + issue.Key + " JSON..."
+			m.statusClearAt = time.Now().Add(30 * time.Second)
+			cfg := *m.cfg
+			return m, func() tea.Msg {
+				text, err := fetchIssueJSON(&cfg, issue.Key)
+				return rawIssueMsg{key: issue.Key, json: text, err: err}
+			}
+		case key == "f":
+			// Toggle the tree filter: the selected issue (or a subtask's parent) and its descendants
+			if m.treeRoot != "" {
+				m.treeRoot = ""
+			} else if issue, ok := m.currentIssue(); ok {
+				m.treeRoot = issue.Key
+				if issue.Fields.IssueType.Subtask && issue.Fields.Parent.Key != "" {
+					m.treeRoot = issue.Fields.Parent.Key
+				}
+			} else {
+				return m, nil
+			}
+			for i := range m.columns {
+				m.columns[i].issues = m.filterAndGroupColumn(m.columns[i].title, m.columns[i].allIssues, m.filter)
+				if m.columns[i].cursor >= len(m.columns[i].issues) {
+					m.columns[i].cursor = max(0, len(m.columns[i].issues)-1)
+				}
+				m.ensureCursorVisible(&m.columns[i])
+			}
+			return m, nil
+		case key == "C":
+			m.creating = true
+			m.createInput.SetValue("")
+			m.createInput.Focus()
+			return m, textinput.Blink
+		case key == "A":
+			if issue, ok := m.currentIssue(); ok {
+				m.assignKey = issue.Key
+				m.userMatches = nil
+				m.assignInput.SetValue("")
+				m.assignInput.Focus()
+				return m, textinput.Blink
+			}
+			return m, nil
+		case key == "z":
+			// cycle row density: compact -> normal -> detailed; denser layouts need more fields
+			m.layout = (m.layout + 1) % 3
+			_ = usercfg.UpdateUIPrefs(func(prefs *usercfg.UIPreferences) {
+				prefs.RowLayout = m.layout.configString()
+			})
+			for i := range m.columns {
+				m.ensureCursorVisible(&m.columns[i])
+			}
+			m.statusMsg = "Layout: " + m.layout.String()
+			m.statusClearAt = time.Now().Add(2 * time.Second)
+			cmds := []tea.Cmd{tea.Tick(2*time.Second, func(t time.Time) tea.Msg {
+				return clearStatusMsg{}
+			})}
+			if m.layout != rowCompact {
+				m.loading = true
+				cmds = append(cmds, m.loadDataCmd())
+			}
+			return m, tea.Batch(cmds...)
+		case key == "F":
+			m.focusMode = !m.focusMode
+			_ = usercfg.UpdateUIPrefs(func(prefs *usercfg.UIPreferences) {
+				prefs.FocusMode = m.focusMode
+			})
+			if m.focusMode && m.isDoneColumn(m.selectedCol) {
+				m.selectedCol = m.nextVisibleColumn(1)
+				m.ensureCursorVisible(&m.columns[m.selectedCol])
+			}
+			m.statusMsg = "Focus mode off"
+			if m.focusMode {
+				m.statusMsg = "Focus mode: Done and empty columns hidden"
+			}
+			m.statusClearAt = time.Now().Add(2 * time.Second)
+			return m, tea.Tick(2*time.Second, func(t time.Time) tea.Msg {
+				return clearStatusMsg{}
+			})
+		case key == "S":
+			// Start work without leaving the board: check out the branch, then move the issue
+			issue, ok := m.currentIssue()
+			if !ok {
+				return m, nil
+			}
+			return m.startWork(issue, createBranchName(m.cfg, issue), false)
+		case key == "B":
+			m.blockedOnly = !m.blockedOnly
+			m.regroupColumns()
+			m.statusMsg = "Showing all issues"
+			if m.blockedOnly {
+				m.statusMsg = "Showing only flagged and blocked issues"
+			}
+			m.statusClearAt = time.Now().Add(2 * time.Second)
+			return m, tea.Tick(2*time.Second, func(t time.Time) tea.Msg {
+				return clearStatusMsg{}
+			})
+		case key == "x":
+			issue, ok := m.currentIssue()
+			if !ok {
+				return m, nil
+			}
+			m.hidden[issue.Key] = true
+			m.regroupColumns()
+			m.statusMsg = fmt.Sprintf("Hid %s for this session (X shows hidden issues)", issue.Key)
+			m.statusClearAt = time.Now().Add(2 * time.Second)
+			return m, tea.Tick(2*time.Second, func(t time.Time) tea.Msg {
+				return clearStatusMsg{}
+			})
+		case key == "X":
+			if len(m.hidden) == 0 {
+				return m, nil
+			}
+			m.statusMsg = fmt.Sprintf("Showing %d hidden issues", len(m.hidden))
+			clear(m.hidden)
+			m.regroupColumns()
+			m.statusClearAt = time.Now().Add(2 * time.Second)
+			return m, tea.Tick(2*time.Second, func(t time.Time) tea.Msg {
+				return clearStatusMsg{}
+			})
+		case key == "E":
+			if !m.cfg.ShowEpics {
+				m.statusMsg = "Epics are off; enable with: gci config set show_epics true"
+			} else {
+				m.epicSort = !m.epicSort
+				m.regroupColumns()
+				m.statusMsg = "Epic grouping off"
+				if m.epicSort {
+					m.statusMsg = "Grouped by epic"
+				}
+			}
+			m.statusClearAt = time.Now().Add(2 * time.Second)
+			return m, tea.Tick(2*time.Second, func(t time.Time) tea.Msg {
+				return clearStatusMsg{}
+			})
+		case key == "L":
+			if issue, ok := m.currentIssue(); ok {
+				m.worklogKey = issue.Key
+				m.worklogSpent = ""
+				m.worklogInput.Placeholder = "e.g. 1h 30m"
+				m.worklogInput.SetValue("")
+				m.worklogInput.Focus()
+				return m, textinput.Blink
+			}
+			return m, nil
+		case key == "t":
+			// cycle subtask display: grouped -> flat -> parents only
+			m.hierarchy = (m.hierarchy + 1) % 3
+			for i := range m.columns {
+				m.columns[i].issues = m.filterAndGroupColumn(m.columns[i].title, m.columns[i].allIssues, m.filter)
+				if m.columns[i].cursor >= len(m.columns[i].issues) {
+					m.columns[i].cursor = max(0, len(m.columns[i].issues)-1)
+				}
+				m.ensureCursorVisible(&m.columns[i])
+			}
+			m.statusMsg = "Subtasks: " + m.hierarchy.String()
+			m.statusClearAt = time.Now().Add(2 * time.Second)
+			return m, tea.Tick(2*time.Second, func(t time.Time) tea.Msg {
+				return clearStatusMsg{}
+			})
+		case key == "u":
+			if issue, ok := m.currentIssue(); ok {
+				cmd := m.copyToClipboard(issueURL(m.cfg, issue.Key), "Copied URL")
+				return m, cmd
+			}
 		case key == "b":
 			// If filtered results are in a different column, jump there
 			if _, ok := m.currentIssue(); !ok {
@@ -606,13 +1901,7 @@ func (m boardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 			}
 			if issue, ok := m.currentIssue(); ok {
-				branch := createBranchName(issue)
-				if err := createOrCheckoutBranch(branch); err != nil {
-					m.err = err
-					return m, nil
-				}
-				m.saveUIPreferences()
-				return m, tea.Quit
+				return m.branchAndQuit(issue, createBranchName(m.cfg, issue), false)
 			}
 		case key == "enter":
 			// Interactive Mode: behavior depends on EnableClaude and EnableWorktrees config
@@ -626,62 +1915,56 @@ func (m boardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 			}
 			if issue, ok := m.currentIssue(); ok {
-				branch := createBranchName(issue)
-				m.pendingIssue = issue
-
-				if m.cfg.EnableWorktrees {
-					// Worktree path
-					result := createOrCheckoutWorktree(branch)
-					if result.Error != nil {
-						// Fallback to branch in current directory
-						if err := createOrCheckoutBranch(branch); err != nil {
-							m.err = result.Error
-							return m, nil
-						}
-						m.saveUIPreferences()
-						fmt.Printf("\n\033[92mBranch ready: %s\033[0m\n", branch)
-						m.pendingWorktree = "."
-					} else {
-						m.saveUIPreferences()
-						fmt.Printf("\n\033[92mWorktree ready: %s\033[0m\n", result.Path)
-						m.pendingWorktree = result.Path
-					}
-				} else {
-					// Branch-only path
-					if err := createOrCheckoutBranch(branch); err != nil {
-						m.err = err
-						return m, nil
-					}
-					m.saveUIPreferences()
-					fmt.Printf("\n\033[92mBranch ready: %s\033[0m\n", branch)
-					m.pendingWorktree = "."
-				}
-
-				if m.cfg.EnableClaude {
-					fmt.Printf("\033[93mSpawning Claude with ticket context...\033[0m\n")
-					m.pendingClaude = true
-				} else {
-					// Print ticket info for non-Claude flow
-					description := extractDescriptionText(issue)
-					fmt.Printf("\n\033[96m%s: %s\033[0m\n", issue.Key, issue.Fields.Summary)
-					if description != "" {
-						fmt.Printf("\n%s\n", description)
-					}
-					fmt.Println()
-				}
-				return m, tea.Quit
+				return m.interactiveMode(issue, createBranchName(m.cfg, issue), false)
 			}
 		case key == "r":
 			m.loading = true
+			if m.cfg.UnhideOnRefresh {
+				clear(m.hidden)
+			}
+			return m, m.loadDataCmd()
+		case key == "R":
+			// Drop every cached scope, issue detail, and epic so nothing stale survives
+			m.scopeGen++
+			if m.scopeCancel != nil {
+				m.scopeCancel()
+				m.scopeCancel = nil
+			}
+			for i := range m.columns {
+				m.columns[i].allByScope = nil
+			}
+			m.issueDetails = make(map[string]JiraIssue)
+			m.epics = make(map[string]epicInfo)
+			m.loading = true
+			m.rebuilding = true
 			return m, m.loadDataCmd()
+		case key == "+" || key == "=" || key == "-":
+			delta := columnResizeStep
+			if key == "-" {
+				delta = -delta
+			}
+			if !m.resizeSelectedColumn(delta) {
+				m.statusMsg = "Column is at its size limit"
+				m.statusClearAt = time.Now().Add(2 * time.Second)
+				return m, tea.Tick(2*time.Second, func(t time.Time) tea.Msg {
+					return clearStatusMsg{}
+				})
+			}
+			for i := range m.columns {
+				m.ensureCursorVisible(&m.columns[i])
+			}
+		case key == "<" || key == "shift+left":
+			m.moveSelectedColumn(-1)
+		case key == ">" || key == "shift+right":
+			m.moveSelectedColumn(1)
 		// Navigation last so action keys like w/s don't get shadowed if users add them to movement
 		case key == "l" || key == "right" || key == "tab":
-			m.selectedCol = (m.selectedCol + 1) % len(m.columns)
+			m.selectedCol = m.nextVisibleColumn(1)
 			if len(m.columns) > 0 {
 				m.ensureCursorVisible(&m.columns[m.selectedCol])
 			}
 		case key == "h" || key == "left" || key == "shift+tab":
-			m.selectedCol = (m.selectedCol - 1 + len(m.columns)) % len(m.columns)
+			m.selectedCol = m.nextVisibleColumn(-1)
 			if len(m.columns) > 0 {
 				m.ensureCursorVisible(&m.columns[m.selectedCol])
 			}
@@ -701,8 +1984,13 @@ func (m boardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 	case dataLoadedMsg:
 		m.loading = false
+		m.rebuilding = false
 		m.err = nil
-		m.columns = msg.columns
+		m.loadedAt = time.Now()
+		selected := m.cursorKeys()
+		// Keep the current layout if columns were reordered while loading
+		m.columns = applyColumnOrder(msg.columns, m.columnTitles())
+		restoreCursors(m.columns, selected)
 		for i := range m.columns {
 			m.ensureCursorVisible(&m.columns[i])
 		}
@@ -713,7 +2001,8 @@ func (m boardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cfg := *m.cfg
 		cmds := make([]tea.Cmd, 0, len(scopes)-1)
 		for _, sc := range scopes {
-			if sc == m.curScope {
+			// A preset replaces the scope, so there is nothing else to prefetch
+			if sc == m.curScope || m.preset != "" {
 				continue
 			}
 			scLocal := sc // This alone isn't enough - need to pass to closure
@@ -721,46 +2010,473 @@ func (m boardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return func() tea.Msg {
 					return m.loadScopeConcurrently(cfg, colsSnapshot, scope)
 				}
-			}(scLocal))
-		}
-		return m, tea.Batch(cmds...)
-	case lazyBatchLoadedMsg:
-		// Populate caches and, if current scope matches, refresh visible data
-		for idx, issues := range msg.byIndex {
-			if idx < 0 || idx >= len(m.columns) {
-				continue
-			}
-			if m.columns[idx].allByScope == nil {
-				m.columns[idx].allByScope = make(map[scopeFilter][]JiraIssue)
-			}
-			m.columns[idx].allByScope[msg.scope] = issues
-			if msg.scope == m.curScope {
-				m.columns[idx].allIssues = issues
-				m.columns[idx].issues = m.filterAndGroupColumn(m.columns[idx].title, issues, m.filter)
-				m.ensureCursorVisible(&m.columns[idx])
+			}(scLocal))
+		}
+		cmds = append(cmds, m.epicLookupCmd())
+		return m, tea.Batch(cmds...)
+	case scopeFetchMsg:
+		if msg.gen != m.scopeGen {
+			// superseded by a later scope change
+			return m, nil
+		}
+		var missing []kanbanColumnView
+		for i := range m.columns {
+			if _, ok := m.columns[i].allByScope[msg.scope]; !ok {
+				missing = append(missing, m.columns[i])
+			}
+		}
+		if len(missing) == 0 {
+			return m, nil
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), loadTimeout(*m.cfg, defaultScopeLoadTimeout))
+		m.scopeCancel = cancel
+		cfg := *m.cfg
+		return m, func() tea.Msg {
+			defer cancel()
+			byColumn := make(map[string][]JiraIssue, len(missing))
+			for _, col := range missing {
+				issues, err := fetchColumnIssuesWithContext(ctx, &cfg, col.statusCategory, msg.scope, 100)
+				if err != nil {
+					continue
+				}
+				byColumn[col.title] = issues
+			}
+			return lazyBatchLoadedMsg{scope: msg.scope, byColumn: applyStatusOverrides(byColumn, cfg.ColumnOverrides)}
+		}
+	case lazyBatchLoadedMsg:
+		// Populate caches and, if current scope matches, refresh visible data
+		for title, issues := range msg.byColumn {
+			idx := m.columnIndex(title)
+			if idx < 0 {
+				continue
+			}
+			if m.columns[idx].allByScope == nil {
+				m.columns[idx].allByScope = make(map[scopeFilter][]JiraIssue)
+			}
+			m.columns[idx].allByScope[msg.scope] = issues
+			if msg.scope == m.curScope {
+				m.columns[idx].allIssues = issues
+				m.columns[idx].issues = m.filterAndGroupColumn(m.columns[idx].title, issues, m.filter)
+				m.ensureCursorVisible(&m.columns[idx])
+			}
+		}
+		return m, m.epicLookupCmd()
+	case pullRequestsMsg:
+		switch {
+		case msg.err != nil:
+			m.statusMsg = "Pull requests unavailable: " + msg.err.Error()
+		case len(msg.prs) == 0:
+			m.statusMsg = "No pull requests linked to " + msg.key
+		case len(msg.prs) == 1:
+			m.statusMsg = "Opened " + msg.prs[0].ID + " " + msg.prs[0].Name
+			if err := openPullRequestURL(msg.prs[0].URL); err != nil {
+				m.statusMsg = "Open failed: " + err.Error()
+			}
+		default:
+			m.statusMsg = ""
+			m.prKey = msg.key
+			m.prList = msg.prs
+			return m, nil
+		}
+		m.statusClearAt = time.Now().Add(3 * time.Second)
+		return m, tea.Tick(3*time.Second, func(t time.Time) tea.Msg {
+			return clearStatusMsg{}
+		})
+	case rawIssueMsg:
+		if msg.err != nil {
+			m.statusMsg = "Fetch failed: " + msg.err.Error()
+			m.statusClearAt = time.Now().Add(3 * time.Second)
+			return m, tea.Tick(3*time.Second, func(t time.Time) tea.Msg {
+				return clearStatusMsg{}
+			})
+		}
+		m.statusMsg = ""
+		m.rawJSON = msg.key + " raw JSON\n\n" + msg.json
+		m.showingHelp = true
+		m.helpOffset = 0
+		return m, nil
+	case columnCheckMsg:
+		m.columnWarnings = msg.warnings
+		return m, nil
+	case epicsLoadedMsg:
+		for key, epic := range msg.epics {
+			m.epics[key] = epic
+		}
+		if m.epicSort {
+			m.regroupColumns()
+		}
+		return m, nil
+	case errMsg:
+		m.loading = false
+		m.err = msg.err
+		return m, nil
+	case issueDetailsLoadedMsg:
+		if m.issueDetails == nil {
+			m.issueDetails = make(map[string]JiraIssue)
+		}
+		// On failure, cache the list data so the row stops showing as loading
+		m.issueDetails[msg.issue.Key] = msg.issue
+		if msg.err != nil {
+			m.statusMsg = "Details failed: " + msg.err.Error()
+			m.statusClearAt = time.Now().Add(3 * time.Second)
+			return m, tea.Tick(3*time.Second, func(t time.Time) tea.Msg {
+				return clearStatusMsg{}
+			})
+		}
+		return m, nil
+	case issueCreatedMsg:
+		if msg.err != nil {
+			m.statusMsg = "Create failed: " + msg.err.Error()
+		} else {
+			m.statusMsg = "Created " + msg.issue.Key
+			m.insertCreatedIssue(msg.issue)
+		}
+		m.statusClearAt = time.Now().Add(3 * time.Second)
+		return m, tea.Tick(3*time.Second, func(t time.Time) tea.Msg {
+			return clearStatusMsg{}
+		})
+	case usersFoundMsg:
+		if m.assignKey == "" {
+			return m, nil
+		}
+		if msg.err != nil || len(msg.users) == 0 {
+			m.statusMsg = "No matching users"
+			if msg.err != nil {
+				m.statusMsg = "User search failed: " + msg.err.Error()
+			}
+			m.statusClearAt = time.Now().Add(3 * time.Second)
+			return m, tea.Tick(3*time.Second, func(t time.Time) tea.Msg {
+				return clearStatusMsg{}
+			})
+		}
+		m.userMatches = msg.users
+		m.userCursor = 0
+		return m, nil
+	case issueAssignedMsg:
+		if msg.err != nil {
+			m.statusMsg = "Assign failed: " + msg.err.Error()
+		} else {
+			m.statusMsg = fmt.Sprintf("Assigned %s to %s", msg.key, msg.user.DisplayName)
+			m.applyAssignee(msg.key, msg.user)
+		}
+		m.statusClearAt = time.Now().Add(3 * time.Second)
+		cmds := []tea.Cmd{tea.Tick(3*time.Second, func(t time.Time) tea.Msg {
+			return clearStatusMsg{}
+		})}
+		// Scopes keyed on the assignee may no longer include the issue
+		if msg.err == nil && m.curScope != scopeReported {
+			m.loading = true
+			cmds = append(cmds, m.loadDataCmd())
+		}
+		return m, tea.Batch(cmds...)
+	case startWorkMsg:
+		if msg.checkoutErr != nil {
+			var collision *branchCollisionError
+			if errors.As(msg.checkoutErr, &collision) {
+				m.statusMsg = ""
+				return m.checkoutFailed("S", msg.issue, msg.checkoutErr)
+			}
+			m.statusMsg = "Branch failed: " + msg.checkoutErr.Error()
+			m.statusClearAt = time.Now().Add(5 * time.Second)
+			return m, tea.Tick(5*time.Second, func(t time.Time) tea.Msg {
+				return clearStatusMsg{}
+			})
+		}
+		// The branch is already checked out, so a failed transition is a partial success
+		var cmds []tea.Cmd
+		if msg.err != nil {
+			m.statusMsg = fmt.Sprintf("On %s • transition failed: %v", msg.branch, msg.err)
+		} else {
+			m.statusMsg = fmt.Sprintf("On %s • %s", msg.branch, msg.reason)
+			if msg.notes != "" {
+				m.statusMsg += " • " + msg.notes
+			}
+			if !msg.skipped {
+				m.loading = true
+				cmds = append(cmds, m.loadDataCmd())
+			}
+		}
+		m.statusClearAt = time.Now().Add(5 * time.Second)
+		cmds = append(cmds, tea.Tick(5*time.Second, func(t time.Time) tea.Msg {
+			return clearStatusMsg{}
+		}))
+		return m, tea.Batch(cmds...)
+	case bulkTransitionMsg:
+		switch {
+		case msg.err != nil:
+			m.bulkProblems = append(m.bulkProblems, fmt.Sprintf("%s failed: %v", msg.issue.Key, msg.err))
+		case msg.skipped:
+			m.bulkProblems = append(m.bulkProblems, msg.reason)
+		default:
+			m.bulkMoved++
+		}
+		if len(m.bulkQueue) > 0 {
+			cmd := m.nextBulkTransition()
+			return m, cmd
+		}
+		m.statusMsg = fmt.Sprintf("Moved %d/%d to %s", m.bulkMoved, m.bulkTotal, m.bulkTarget)
+		if len(m.bulkProblems) > 0 {
+			m.statusMsg += " — " + strings.Join(m.bulkProblems, "; ")
+		}
+		m.selecting = false
+		m.marked = nil
+		m.statusClearAt = time.Now().Add(10 * time.Second)
+		m.loading = true
+		return m, tea.Batch(m.loadDataCmd(), tea.Tick(10*time.Second, func(t time.Time) tea.Msg {
+			return clearStatusMsg{}
+		}))
+	case worklogAddedMsg:
+		if msg.err != nil {
+			m.statusMsg = "Log work failed: " + msg.err.Error()
+		} else {
+			m.statusMsg = fmt.Sprintf("Logged %s on %s", msg.spent, msg.key)
+		}
+		m.statusClearAt = time.Now().Add(3 * time.Second)
+		return m, tea.Tick(3*time.Second, func(t time.Time) tea.Msg {
+			return clearStatusMsg{}
+		})
+	case updateCheckedMsg:
+		if msg.newVersion != "" {
+			m.statusMsg = ""
+			m.updateVersion = msg.newVersion
+			return m, nil
+		}
+		if version.GetShortVersion() == "dev" {
+			m.statusMsg = "Update check skipped for dev builds"
+		} else {
+			m.statusMsg = fmt.Sprintf("gci %s is the latest release", version.GetShortVersion())
+		}
+		m.statusClearAt = time.Now().Add(3 * time.Second)
+		return m, tea.Tick(3*time.Second, func(t time.Time) tea.Msg {
+			return clearStatusMsg{}
+		})
+	case clearStatusMsg:
+		if time.Now().After(m.statusClearAt) || time.Now().Equal(m.statusClearAt) {
+			m.statusMsg = ""
+		}
+		return m, nil
+	}
+	return m, nil
+}
+
+// noResultsHint explains an empty board once every visible column has loaded with
+// nothing in it, so "nothing matches" doesn't look like "still loading"
+func (m boardModel) noResultsHint(visible []int) string {
+	if m.loading || m.err != nil || len(visible) == 0 {
+		return ""
+	}
+	for _, i := range visible {
+		c := m.columns[i]
+		if _, loaded := c.allByScope[m.curScope]; !loaded || len(c.issues) > 0 {
+			return ""
+		}
+	}
+	// A preset replaces the scope, and s is refused while one is active
+	source := fmt.Sprintf("scope '%s'", scopeToString(m.curScope))
+	change := "s to change scope"
+	if m.preset != "" {
+		source = fmt.Sprintf("preset '%s'", m.preset)
+		change = "p to change preset"
+	}
+
+	// Everything else narrowing the columns, with the key that undoes it
+	var narrowed, undo []string
+	if m.filter != "" {
+		narrowed = append(narrowed, fmt.Sprintf("filter %q", m.filter))
+		undo = append(undo, "/ to adjust the filter")
+	}
+	if m.blockedOnly {
+		narrowed = append(narrowed, "blocked only")
+		undo = append(undo, "B to show all issues")
+	}
+	if m.treeRoot != "" {
+		narrowed = append(narrowed, m.treeRoot+" tree")
+		undo = append(undo, "f to clear the tree filter")
+	}
+	if len(m.hidden) > 0 {
+		narrowed = append(narrowed, fmt.Sprintf("%d hidden", len(m.hidden)))
+		undo = append(undo, "X to show hidden issues")
+	}
+	if len(narrowed) == 0 {
+		return fmt.Sprintf("No issues for %s. Press %s or / to filter.", source, change)
+	}
+	return fmt.Sprintf("No issues for %s with %s. Press %s, or %s.", source, strings.Join(narrowed, ", "), strings.Join(undo, ", "), change)
+}
+
+// branchCollisionPrompt is the question the board asks when an issue's branch already
+// exists but looks unrelated: take it over, or create alt instead
+type branchCollisionPrompt struct {
+	action string // the key that asked: "b", "enter", or "S"
+	issue  JiraIssue
+	branch string
+	alt    string
+}
+
+// checkoutFailed handles a failed checkout for action: a collision opens the board's
+// own prompt, anything else is shown as an error
+func (m boardModel) checkoutFailed(action string, issue JiraIssue, err error) (tea.Model, tea.Cmd) {
+	var collision *branchCollisionError
+	if errors.As(err, &collision) {
+		m.collision = &branchCollisionPrompt{action: action, issue: issue, branch: collision.branch, alt: collision.alt}
+		return m, nil
+	}
+	m.err = err
+	return m, nil
+}
+
+// updateCollisionPrompt handles keys while the branch collision prompt is open
+func (m boardModel) updateCollisionPrompt(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	prompt := *m.collision
+	switch msg.String() {
+	case "c":
+		m.collision = nil
+		return m.retryCheckout(prompt, prompt.branch)
+	case "n":
+		m.collision = nil
+		return m.retryCheckout(prompt, prompt.alt)
+	case "esc", "q", "ctrl+c":
+		m.collision = nil
+		m.statusMsg = fmt.Sprintf("Left %s alone", prompt.branch)
+		m.statusClearAt = time.Now().Add(2 * time.Second)
+		return m, tea.Tick(2*time.Second, func(t time.Time) tea.Msg {
+			return clearStatusMsg{}
+		})
+	}
+	return m, nil
+}
+
+// retryCheckout repeats the action that hit a collision on branch, taking it over
+func (m boardModel) retryCheckout(prompt branchCollisionPrompt, branch string) (tea.Model, tea.Cmd) {
+	switch prompt.action {
+	case "enter":
+		return m.interactiveMode(prompt.issue, branch, true)
+	case "S":
+		return m.startWork(prompt.issue, branch, true)
+	default:
+		return m.branchAndQuit(prompt.issue, branch, true)
+	}
+}
+
+// branchAndQuit is b: check out the issue's branch, then leave the board
+func (m boardModel) branchAndQuit(issue JiraIssue, branch string, takeOver bool) (tea.Model, tea.Cmd) {
+	if _, err := createOrCheckoutBranch(branch, branchOptions{issueKey: issue.Key, onDirtyTree: m.cfg.OnDirtyTree, takeOver: takeOver}); err != nil {
+		return m.checkoutFailed("b", issue, err)
+	}
+	m.saveUIPreferences()
+	m.pendingIssue = issue
+	m.pendingStart = m.cfg.OnStartTransition != ""
+	return m, tea.Quit
+}
+
+// interactiveMode is enter: a worktree (or branch) for the issue, then Claude or the
+// ticket context once the board exits, depending on EnableClaude and EnableWorktrees
+func (m boardModel) interactiveMode(issue JiraIssue, branch string, takeOver bool) (tea.Model, tea.Cmd) {
+	opts := branchOptions{issueKey: issue.Key, onDirtyTree: m.cfg.OnDirtyTree, takeOver: takeOver}
+	if m.cfg.EnableWorktrees {
+		// Worktree path
+		result := createOrCheckoutWorktree(branch, m.cfg.WorktreeBaseDir, m.cfg.OnDirtyTree)
+		if result.Error != nil {
+			// Fallback to branch in current directory
+			var err error
+			if branch, err = createOrCheckoutBranch(branch, opts); err != nil {
+				var collision *branchCollisionError
+				if errors.As(err, &collision) {
+					return m.checkoutFailed("enter", issue, err)
+				}
+				m.err = result.Error
+				return m, nil
 			}
+			m.saveUIPreferences()
+			fmt.Printf("\n\033[92mBranch ready: %s\033[0m\n", branch)
+			m.pendingWorktree = "."
+		} else {
+			m.saveUIPreferences()
+			fmt.Printf("\n\033[92mWorktree ready: %s\033[0m\n", result.Path)
+			m.pendingWorktree = result.Path
 		}
-		return m, nil
-	case errMsg:
-		m.loading = false
-		m.err = msg.err
-		return m, nil
-	case clearStatusMsg:
-		if time.Now().After(m.statusClearAt) || time.Now().Equal(m.statusClearAt) {
-			m.statusMsg = ""
+	} else {
+		// Branch-only path
+		var err error
+		if branch, err = createOrCheckoutBranch(branch, opts); err != nil {
+			return m.checkoutFailed("enter", issue, err)
 		}
-		return m, nil
+		m.saveUIPreferences()
+		fmt.Printf("\n\033[92mBranch ready: %s\033[0m\n", branch)
+		m.pendingWorktree = "."
+	}
+
+	m.pendingIssue = issue
+	m.pendingStart = m.cfg.OnStartTransition != ""
+	if m.cfg.EnableClaude {
+		if _, err := lookPath("claude"); err != nil {
+			fmt.Printf("\033[93mclaude not found in PATH — showing ticket context instead\033[0m\n")
+			printTicketContext(issue)
+		} else {
+			fmt.Printf("\033[93mSpawning Claude with ticket context...\033[0m\n")
+			m.pendingClaude = true
+		}
+	} else {
+		printTicketContext(issue)
+	}
+	return m, tea.Quit
+}
+
+// startWork is S: check out the issue's branch without leaving the board, then move
+// the issue to the start transition. Both run in a command with git's progress
+// captured, so nothing prints over the board or prompts; S never uses a worktree.
+func (m boardModel) startWork(issue JiraIssue, branch string, takeOver bool) (tea.Model, tea.Cmd) {
+	m.saveUIPreferences()
+	target := startTransitionTarget(m.cfg)
+	m.statusMsg = fmt.Sprintf("Checking out %s...", branch)
+	m.statusClearAt = time.Now().Add(30 * time.Second)
+	cfg := *m.cfg
+	return m, func() tea.Msg {
+		var out strings.Builder
+		branch, err := createOrCheckoutBranch(branch, branchOptions{issueKey: issue.Key, onDirtyTree: cfg.OnDirtyTree, takeOver: takeOver, out: &out})
+		notes := strings.ReplaceAll(strings.TrimSpace(out.String()), "\n", " ")
+		msg := startWorkMsg{issue: issue, branch: branch, notes: notes, checkoutErr: err}
+		if err != nil {
+			return msg
+		}
+		msg.skipped, msg.reason, msg.err = transitionIssue(&cfg, issue, target)
+		return msg
 	}
-	return m, nil
 }
 
 func (m boardModel) View() string {
+	if m.tooSmall {
+		// Wrapped rather than clipped so the sizes stay readable in a narrow pane
+		msg := fmt.Sprintf("Terminal too small (need ≥ %dx%d), resize or press q", minBoardWidth, minBoardHeight)
+		return lipgloss.NewStyle().Width(max(1, m.width)).Render(msg)
+	}
+
 	// Show current mode (scope)
 	modeStr := fmt.Sprintf("Scope: %s", scopeToString(m.curScope))
+	if m.preset != "" {
+		modeStr = "Preset: " + m.preset
+	}
+	if m.hierarchy != hierarchyGrouped {
+		modeStr += " — Subtasks: " + m.hierarchy.String()
+	}
+	if m.focusMode {
+		modeStr += " — Focus"
+	}
+	if m.blockedOnly {
+		modeStr += " — Blocked only"
+	}
 
 	header := m.styles.header.Render(clip(fmt.Sprintf("Personal Kanban — Projects: %s — %s", strings.Join(m.cfg.Projects, ","), modeStr), m.width))
 	// Compact help to avoid overflowing small terminals; full help with '?'
 	helpText := "(? help • q quit • arrows/hjkl move • / filter • b branch • c copy • enter interactive)"
+	if m.treeRoot != "" {
+		helpText = fmt.Sprintf("Filtered to %s tree (f to clear • ? help)", m.treeRoot)
+	}
+	if len(m.hidden) > 0 {
+		helpText = fmt.Sprintf("(%d hidden • X to show) ", len(m.hidden)) + helpText
+	}
+	if m.selecting {
+		helpText = fmt.Sprintf("Select mode: space mark • T transition %d marked • v/esc exit", len(m.marked))
+	}
 	if m.statusMsg != "" {
 		helpText = m.statusMsg
 	}
@@ -771,28 +2487,25 @@ func (m boardModel) View() string {
 		return header + "\n" + "No columns configured" + "\n"
 	}
 
-	// Column width percentages: To Do 35%, In Progress 35%, Done 30%
-	var colWidths []int
-	if cols > 0 {
-		// Leave some margin for borders/padding
-		usableWidth := m.width - 6 // account for borders and spacing
-		colWidths = []int{
-			int(float64(usableWidth) * 0.35), // To Do: 35%
-			int(float64(usableWidth) * 0.35), // In Progress: 35%
-			int(float64(usableWidth) * 0.30), // Done: 30%
-		}
-		// Ensure minimum widths
-		for i := range colWidths {
-			colWidths[i] = max(16, colWidths[i])
+	// Focus mode may hide columns; widths are shared among the visible ones
+	var visible []int
+	for i := range m.columns {
+		if m.columnVisible(i) {
+			visible = append(visible, i)
 		}
 	}
 
+	colWidths := m.columnWidths(visible)
+
 	// Compute how many list rows are available per column for ITEMS (not including
 	// the top/bottom indicator lines).
 	itemsWindow := m.itemsWindowCount()
 
-	rendered := make([]string, cols)
+	rendered := make([]string, 0, cols)
 	for i, c := range m.columns {
+		if !m.columnVisible(i) || (m.singleColumn && i != m.selectedCol) {
+			continue
+		}
 		var items []string
 		if len(c.issues) == 0 {
 			// Show loading only if we have no cached data for the current scope.
@@ -804,7 +2517,7 @@ func (m boardModel) View() string {
 			}
 		} else {
 			start := m.columns[i].offset
-			end := min(len(c.issues), start+itemsWindow)
+			end := min(len(c.issues), start+m.columnItemsWindow(c, itemsWindow))
 
 			// Top indicator or spacer
 			if start > 0 {
@@ -812,12 +2525,12 @@ func (m boardModel) View() string {
 			} else {
 				items = append(items, "")
 			}
-			// Pre-scan: show section tags only when To Do has a mix of backlog + non-backlog
+			// Pre-scan: show section tags only when a separating column has a mix of backlog + non-backlog
 			hasBacklogMix := false
-			if c.title == "To Do" {
+			if separatesBacklog(m.cfg.SeparateBacklog, c.title) {
 				hasBacklog, hasNonBacklog := false, false
 				for _, it := range c.issues {
-					if strings.Contains(strings.ToLower(it.Fields.Status.Name), "backlog") {
+					if isBacklogIssue(it) {
 						hasBacklog = true
 					} else {
 						hasNonBacklog = true
@@ -828,31 +2541,48 @@ func (m boardModel) View() string {
 					}
 				}
 			}
+			// In parents-only mode, count the subtasks hidden under each parent
+			var hiddenSubtasks map[string]int
+			if m.hierarchy == hierarchyParentsOnly {
+				hiddenSubtasks = make(map[string]int)
+				for _, it := range c.allIssues {
+					if it.Fields.IssueType.Subtask && it.Fields.Parent.Key != "" {
+						hiddenSubtasks[it.Fields.Parent.Key]++
+					}
+				}
+			}
 			for idx := start; idx < end; idx++ {
 				// Indent subtasks under parent
 				indent := ""
 				it := c.issues[idx]
-				if it.Fields.IssueType.Subtask && it.Fields.Parent.Key != "" {
+				if m.hierarchy == hierarchyGrouped && it.Fields.IssueType.Subtask && it.Fields.Parent.Key != "" {
 					indent = "  └─ "
 				}
-				// Inline tags when To Do column has mixed backlog and active statuses
+				// Inline tags when the column has mixed backlog and active statuses
 				sectionTag := ""
 				if hasBacklogMix {
-					if strings.Contains(strings.ToLower(it.Fields.Status.Name), "backlog") {
+					if isBacklogIssue(it) {
 						sectionTag = "[Backlog] "
 					} else {
-						sectionTag = "[To Do] "
+						sectionTag = "[" + c.title + "] "
 					}
 				}
+				epicTag, epicColor := m.epicTag(it)
 				// Build basic line
-				basicLine := fmt.Sprintf("%s — %s", it.Key, it.Fields.Summary)
+				basicLine := fmt.Sprintf("%s%s — %s", m.typeMarker(it), it.Key, it.Fields.Summary)
+				if n := hiddenSubtasks[it.Key]; n > 0 {
+					basicLine += fmt.Sprintf(" (+%d)", n)
+				}
 
-				// Add extra fields if enabled
-				uiPrefs := usercfg.GetUIPrefs()
+				// Add extra fields unless compact
 				var extraTags []string
-				if uiPrefs.ShowExtraFields {
-					// Add assignee tag
-					if it.Fields.Assignee.DisplayName != "" {
+				badge := ""
+				if m.layout != rowCompact {
+					// Add assignee tag: a colored initials badge, or the first name
+					if m.cfg.AssigneeDisplay == "badges" {
+						badge = assigneeInitials(it.Fields.Assignee.DisplayName)
+						extraTags = append(extraTags, badge)
+					} else if it.Fields.Assignee.DisplayName != "" {
 						// Use first name only to save space
 						assigneeParts := strings.Fields(it.Fields.Assignee.DisplayName)
 						if len(assigneeParts) > 0 {
@@ -892,17 +2622,56 @@ func (m boardModel) View() string {
 				}
 
 				// Combine line with tags
-				var line string
+				lead := indent + sectionTag
+				if m.selecting {
+					mark := "○ "
+					if _, marked := m.marked[it.Key]; marked {
+						mark = "● "
+					}
+					lead = mark + lead
+				}
+				blockedTag := ""
+				if isBlockedIssue(it) {
+					blockedTag = "⚑ "
+				}
+				line := lead + blockedTag + epicTag + basicLine
 				if len(extraTags) > 0 {
-					tagStr := "[" + strings.Join(extraTags, " ") + "]"
-					line = indent + sectionTag + basicLine + " " + tagStr
-				} else {
-					line = indent + sectionTag + basicLine
+					line += " [" + strings.Join(extraTags, " ") + "]"
 				}
 				if i == m.selectedCol && idx == m.columns[i].cursor {
 					items = append(items, m.styles.selected.Render(clip(line, colWidths[i]-4)))
 				} else {
-					items = append(items, clip(line, colWidths[i]-4))
+					clipped := clip(line, colWidths[i]-4)
+					// Color the tags after clipping so escape codes don't count toward the width
+					if rest, ok := strings.CutPrefix(clipped, lead); ok {
+						styled := lead
+						if blockedTag != "" && strings.HasPrefix(rest, blockedTag) {
+							styled += m.styles.blocked.Render(blockedTag)
+							rest = rest[len(blockedTag):]
+						}
+						if epicColor != "" && strings.HasPrefix(rest, epicTag) {
+							styled += lipgloss.NewStyle().Foreground(lipgloss.Color(epicColor)).Render(epicTag)
+							rest = rest[len(epicTag):]
+						}
+						if at := strings.LastIndex(rest, " ["+badge); badge != "" && badge != "--" && at >= 0 {
+							start := at + len(" [")
+							rest = rest[:start] + m.badgeStyle(it).Render(badge) + rest[start+len(badge):]
+						}
+						clipped = styled + rest
+					}
+					items = append(items, clipped)
+				}
+				if m.layout == rowDetailed {
+					detail := "   " + it.Fields.Status.Name
+					if updated := relativeTime(it.Fields.Updated, time.Now()); updated != "" {
+						detail += " • updated " + updated
+					}
+					items = append(items, m.styles.muted.Render(clip(detail, colWidths[i]-4)))
+				}
+				if it.Key == m.expandedKey {
+					for _, detail := range m.expandedDetailLines(it) {
+						items = append(items, m.styles.muted.Render(clip(detail, colWidths[i]-4)))
+					}
 				}
 			}
 			// Bottom indicator or spacer
@@ -918,31 +2687,140 @@ func (m boardModel) View() string {
 			box = m.styles.boxActive
 		}
 		title := m.styles.title.Render(c.title)
-		rendered[i] = box.Width(colWidths[i]).Render(title + "\n" + strings.Join(items, "\n"))
+		if m.singleColumn {
+			pos := 0
+			for pos < len(visible) && visible[pos] != i {
+				pos++
+			}
+			title += m.styles.muted.Render(fmt.Sprintf(" (%d/%d • ←/→ switch)", pos+1, len(visible)))
+		}
+		rendered = append(rendered, box.Width(colWidths[i]).Render(title+"\n"+strings.Join(items, "\n")))
 	}
 	board := lipgloss.JoinHorizontal(lipgloss.Top, rendered...)
 
 	if m.filtering {
 		return header + "\n" + help + "\n\n" + board + "\n\nFilter: " + m.filterInput.View()
 	}
+	if m.creating {
+		prompt := fmt.Sprintf("New %s in %s: ", m.cfg.DefaultIssueType, quickCreateProject(m.cfg))
+		return header + "\n" + help + "\n\n" + board + "\n\n" + prompt + m.createInput.View()
+	}
+	if m.assignKey != "" {
+		return header + "\n" + help + "\n\n" + board + "\n\n" + m.reassignView()
+	}
+	if m.collision != nil {
+		prompt := fmt.Sprintf("Branch %s already exists but doesn't look related to %s. c check it out • n create %s • esc cancel",
+			m.collision.branch, m.collision.issue.Key, m.collision.alt)
+		return header + "\n" + help + "\n\n" + board + "\n\n" + m.styles.error.Render(clip(prompt, m.width))
+	}
+	if m.bulkPrompt {
+		prompt := fmt.Sprintf("Transition %d marked issues to: ", len(m.marked))
+		return header + "\n" + help + "\n\n" + board + "\n\n" + prompt + m.bulkInput.View()
+	}
+	if m.worklogKey != "" {
+		prompt := fmt.Sprintf("Log work on %s: ", m.worklogKey)
+		if m.worklogSpent != "" {
+			prompt = fmt.Sprintf("Remaining estimate for %s after %s (blank = auto): ", m.worklogKey, m.worklogSpent)
+		}
+		view := header + "\n" + help + "\n\n" + board + "\n\n" + prompt + m.worklogInput.View()
+		if m.statusMsg != "" {
+			view += "\n" + m.styles.error.Render(m.statusMsg)
+		}
+		return view
+	}
 	footer := ""
 	if m.err != nil {
 		footer = "\n" + m.styles.error.Render("Error: "+m.err.Error())
+	} else if m.loading && m.rebuilding {
+		footer = "\n" + m.styles.muted.Render("Rebuilding cache…")
 	} else if m.loading {
 		footer = "\n" + m.styles.muted.Render("Loading...")
 	}
-	if m.filter != "" {
+	if priorities, text := splitPriorityFilter(m.filter); len(priorities) > 0 {
+		footer += "\n" + m.styles.muted.Render("Priority: "+strings.Join(priorities, ", "))
+		if text != "" {
+			footer += "\n" + m.styles.muted.Render("Filter: "+text)
+		}
+	} else if m.filter != "" {
 		footer += "\n" + m.styles.muted.Render("Filter: "+m.filter)
 	}
+	if hint := m.noResultsHint(visible); hint != "" {
+		footer += "\n\n" + lipgloss.PlaceHorizontal(m.width, lipgloss.Center, m.styles.muted.Render(clip(hint, m.width)))
+	}
+	for _, warning := range m.columnWarnings {
+		footer += "\n" + m.styles.error.Render("⚠ "+warning)
+	}
 	baseView := header + "\n" + help + "\n\n" + board + footer + "\n"
 
 	if m.showingHelp {
 		return m.renderWithHelpOverlay(baseView)
 	}
+	if m.updateVersion != "" {
+		return m.renderWithUpdateOverlay(baseView)
+	}
+	if m.prKey != "" {
+		return m.renderWithPullRequestOverlay(baseView)
+	}
 
 	return baseView
 }
 
+// updatePullRequestPicker handles keys while linked pull requests are listed: a number
+// opens that pull request, anything else closes the list
+func (m boardModel) updatePullRequestPicker(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	key := msg.String()
+	if len(key) == 1 && key[0] >= '1' && key[0] <= '9' {
+		if idx := int(key[0] - '1'); idx < len(m.prList) {
+			pr := m.prList[idx]
+			m.statusMsg = "Opened " + pr.ID + " " + pr.Name
+			if err := openPullRequestURL(pr.URL); err != nil {
+				m.statusMsg = "Open failed: " + err.Error()
+			}
+			m.prKey, m.prList = "", nil
+			m.statusClearAt = time.Now().Add(3 * time.Second)
+			return m, tea.Tick(3*time.Second, func(t time.Time) tea.Msg {
+				return clearStatusMsg{}
+			})
+		}
+		return m, nil
+	}
+	m.prKey, m.prList = "", nil
+	return m, nil
+}
+
+// renderWithPullRequestOverlay lists the selected issue's linked pull requests
+func (m boardModel) renderWithPullRequestOverlay(baseView string) string {
+	width := min(90, max(40, m.width-8))
+	lines := []string{m.styles.helpTitle.Render("Pull requests linked to " + m.prKey), ""}
+	for i, pr := range m.prList {
+		if i == 9 {
+			lines = append(lines, m.styles.muted.Render(fmt.Sprintf("… %d more in JIRA's development panel", len(m.prList)-9)))
+			break
+		}
+		line := fmt.Sprintf("%d  %s %s [%s]", i+1, pr.ID, pr.Name, strings.ToLower(pr.Status))
+		lines = append(lines, m.styles.helpKey.Render(line[:1])+clip(line[1:], width-6))
+	}
+	lines = append(lines, "", m.styles.muted.Render("1-9 open • any other key closes"))
+	overlay := m.styles.helpOverlay.Width(width).Render(strings.Join(lines, "\n"))
+	return placeOverlay(baseView, overlay, m.height)
+}
+
+// renderWithUpdateOverlay centers a notice about the newer release found by U over the board
+func (m boardModel) renderWithUpdateOverlay(baseView string) string {
+	lines := []string{
+		m.styles.helpTitle.Render("⬆ Update available"),
+		"",
+		fmt.Sprintf("gci %s is out (you have %s).", m.updateVersion, version.GetShortVersion()),
+		"",
+		"Quit the board and run " + m.styles.helpKey.Render("gci update") + " to upgrade,",
+		"or " + m.styles.helpKey.Render("gci update --dry-run") + " to read the release notes first.",
+		"",
+		m.styles.muted.Render("Press any key to close"),
+	}
+	overlay := m.styles.helpOverlay.Width(min(70, max(40, m.width-8))).Render(strings.Join(lines, "\n"))
+	return placeOverlay(baseView, overlay, m.height)
+}
+
 func (m boardModel) renderWithHelpOverlay(baseView string) string {
 	lines, overlayWidth, viewport := m.helpLayout()
 	// Clamp offset
@@ -972,6 +2850,17 @@ func (m boardModel) renderWithHelpOverlay(baseView string) string {
 	helpBlock := helpContent + "\n" + m.styles.muted.Render(pos)
 	overlay := m.styles.helpOverlay.Width(overlayWidth).Render(helpBlock)
 
+	return placeOverlayAt(baseView, overlay, y)
+}
+
+// placeOverlay replaces the base view's lines with overlay, vertically centered in height
+func placeOverlay(baseView, overlay string, height int) string {
+	y := max(0, (height-lipgloss.Height(overlay))/2)
+	return placeOverlayAt(baseView, overlay, y)
+}
+
+// placeOverlayAt replaces the base view's lines starting at row y with overlay
+func placeOverlayAt(baseView, overlay string, y int) string {
 	// For now, just overlay it on top of the base view
 	// This is a simple approach - could be enhanced with proper layering
 	baseLines := strings.Split(baseView, "\n")
@@ -996,6 +2885,9 @@ func (m boardModel) renderWithHelpOverlay(baseView string) string {
 // helpLayout computes wrapped help lines, target overlay width, and viewport height (content rows)
 func (m boardModel) helpLayout() ([]string, int, int) {
 	helpContent := m.buildHelpContent()
+	if m.rawJSON != "" {
+		helpContent = m.rawJSON
+	}
 	// Width bounds
 	overlayWidth := min(80, max(40, m.width-8))
 	// Wrap
@@ -1024,16 +2916,41 @@ func (m boardModel) buildHelpContent() string {
 		m.styles.helpTitle.Render("Navigation:"),
 		m.styles.helpKey.Render("hjkl/arrows") + " Navigate",
 		m.styles.helpKey.Render("tab/shift+tab") + " Switch column",
+		m.styles.helpKey.Render("< / >") + "       Move column left/right (saved)",
+		m.styles.helpKey.Render("+ / -") + "       Widen/narrow selected column (saved)",
 		"",
 		m.styles.helpTitle.Render("Actions:"),
 		m.styles.helpKey.Render("r") + "           Refresh all columns",
+		m.styles.helpKey.Render("R") + "           Rebuild: drop every cached scope and detail, then reload",
 		m.styles.helpKey.Render("s") + "           Cycle scope (assigned/reported/unassigned)",
-		m.styles.helpKey.Render("/") + "           Filter issues (live search)",
+		m.styles.helpKey.Render("p") + "           Cycle JQL presets (jql_presets) in place of the scope",
+		m.styles.helpKey.Render("/") + "           Filter issues (live search; priority:high,critical keeps those priorities)",
 		m.styles.helpKey.Render("o") + "           Open selected issue in browser",
+		m.styles.helpKey.Render("P") + "           Open the issue's linked pull request (lists them if several)",
+		m.styles.helpKey.Render("J") + "           Show the issue's raw JSON (all fields)",
+		m.styles.helpKey.Render("S") + "           Start work: check out the branch and move the issue to In Progress (no worktree)",
+		m.styles.helpKey.Render("O") + "           Show board JQL, O again opens it in JIRA",
 		m.styles.helpKey.Render("c") + "           Copy issue key to clipboard",
+		m.styles.helpKey.Render("u") + "           Copy issue URL to clipboard",
+		m.styles.helpKey.Render("Y") + "           Copy every listed issue key (one per line)",
+		m.styles.helpKey.Render("t") + "           Cycle subtasks: grouped / flat / parents only",
+		m.styles.helpKey.Render("z") + "           Cycle row layout: compact / normal / detailed (saved)",
+		m.styles.helpKey.Render("F") + "           Focus mode: hide Done and empty columns (saved)",
+		m.styles.helpKey.Render("E") + "           Group issues by epic (needs show_epics)",
+		m.styles.helpKey.Render("B") + "           Show only flagged/blocked issues (⚑)",
+		m.styles.helpKey.Render("x") + "           Hide issue for this session (JIRA is unchanged)",
+		m.styles.helpKey.Render("X") + "           Show hidden issues again",
+		m.styles.helpKey.Render("C") + "           Quick create an issue (summary only)",
+		m.styles.helpKey.Render("A") + "           Reassign issue (search users by name or email)",
+		m.styles.helpKey.Render("L") + "           Log work (e.g. 1h 30m), optionally set remaining",
+		m.styles.helpKey.Render("f") + "           Filter to the selected issue's tree (f again clears)",
+		m.styles.helpKey.Render("v") + "           Select mode: space marks issues (v/esc exits)",
+		m.styles.helpKey.Render("T") + "           Transition all marked issues to a status",
+		m.styles.helpKey.Render("space") + "       Expand/collapse issue details inline",
 		m.styles.helpKey.Render("b") + "           Create/checkout branch for issue",
 		m.styles.helpKey.Render("enter") + "       Interactive Mode",
-		m.styles.helpKey.Render("w") + "           Open setup wizard",
+		m.styles.helpKey.Render("w") + "           Open setup wizard (returns to board)",
+		m.styles.helpKey.Render("U") + "           Check for a gci update now",
 		"",
 		m.styles.helpTitle.Render("Tips:"),
 		"  • Use filters to quickly find issues",
@@ -1060,9 +2977,19 @@ func (m boardModel) currentIssue() (JiraIssue, bool) {
 // given the current terminal height and rough space usage of headers/footers.
 func (m boardModel) viewportItemsHeight() int {
 	reserved := 5
-	if m.filtering {
+	if m.filtering || m.creating {
 		reserved += 2
 	}
+	if m.worklogKey != "" {
+		reserved += 3
+	}
+	if m.bulkPrompt || m.collision != nil {
+		reserved += 2
+	}
+	if m.assignKey != "" {
+		reserved += 2 + len(m.userMatches)
+	}
+	reserved += len(m.columnWarnings)
 	avail := max(5, m.height-reserved)
 	return max(1, avail-3)
 }
@@ -1077,6 +3004,386 @@ func (m boardModel) itemsWindowCount() int {
 	return base - 2
 }
 
+// quickCreateProject picks the project for board quick create: the only configured
+// project, else default_project, else the first configured project
+func quickCreateProject(cfg *Config) string {
+	if len(cfg.Projects) == 1 {
+		return cfg.Projects[0]
+	}
+	if cfg.DefaultProject != "" && containsString(cfg.Projects, cfg.DefaultProject) {
+		return cfg.DefaultProject
+	}
+	if len(cfg.Projects) > 0 {
+		return cfg.Projects[0]
+	}
+	return ""
+}
+
+// quickCreateIssue creates a summary-only issue assigned to the current user
+func quickCreateIssue(cfg *Config, summary string) (JiraIssue, error) {
+	project := quickCreateProject(cfg)
+	if project == "" {
+		return JiraIssue{}, fmt.Errorf("no project configured")
+	}
+	if err := cfg.useProjectToken(project); err != nil {
+		return JiraIssue{}, err
+	}
+	accountId, err := getMyAccountId(cfg)
+	if err != nil {
+		return JiraIssue{}, err
+	}
+	key, err := createJiraIssue(cfg, project, summary, "", cfg.DefaultIssueType, accountId, cfg.DefaultComponents)
+	if err != nil {
+		return JiraIssue{}, err
+	}
+
+	var issue JiraIssue
+	issue.Key = key
+	issue.Fields.Summary = summary
+	issue.Fields.Project.Key = project
+	issue.Fields.IssueType.Name = cfg.DefaultIssueType
+	return issue, nil
+}
+
+// insertCreatedIssue optimistically adds a newly created issue to the top of the To Do
+// column. New issues are assigned to and reported by the user, so every scope but
+// Unassigned would include it.
+func (m *boardModel) insertCreatedIssue(issue JiraIssue) {
+	if m.curScope == scopeUnassigned {
+		return
+	}
+	idx := -1
+	for i := range m.columns {
+		if m.columns[i].statusCategory == m.cfg.statusCategoryName(jira.StatusCategoryNew) {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return
+	}
+	c := &m.columns[idx]
+	c.allIssues = append([]JiraIssue{issue}, c.allIssues...)
+	if c.allByScope == nil {
+		c.allByScope = make(map[scopeFilter][]JiraIssue)
+	}
+	c.allByScope[m.curScope] = c.allIssues
+	c.issues = m.filterAndGroupColumn(c.title, c.allIssues, m.filter)
+	m.ensureCursorVisible(c)
+}
+
+// updateReassign handles keys while the reassign prompt (A) is open: typing a query and
+// pressing enter searches users, then j/k and enter pick the new assignee
+func (m boardModel) updateReassign(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if len(m.userMatches) > 0 {
+		switch msg.String() {
+		case "esc":
+			m.userMatches = nil
+		case "ctrl+c":
+			m.assignKey = ""
+			m.userMatches = nil
+		case "up", "k":
+			if m.userCursor > 0 {
+				m.userCursor--
+			}
+		case "down", "j":
+			if m.userCursor < len(m.userMatches)-1 {
+				m.userCursor++
+			}
+		case "enter":
+			key, user := m.assignKey, m.userMatches[m.userCursor]
+			m.assignKey = ""
+			m.userMatches = nil
+			m.statusMsg = fmt.Sprintf("Assigning %s to %s...", key, user.DisplayName)
+			m.statusClearAt = time.Now().Add(30 * time.Second)
+			cfg := *m.cfg
+			return m, func() tea.Msg {
+				return issueAssignedMsg{key: key, user: user, err: assignIssue(&cfg, key, user.AccountID)}
+			}
+		}
+		return m, nil
+	}
+
+	switch msg.Type {
+	case tea.KeyEsc, tea.KeyCtrlC:
+		m.assignKey = ""
+		return m, nil
+	case tea.KeyEnter:
+		query := strings.TrimSpace(m.assignInput.Value())
+		if query == "" {
+			return m, nil
+		}
+		cfg := *m.cfg
+		return m, func() tea.Msg {
+			users, err := searchUsers(&cfg, query)
+			return usersFoundMsg{users: users, err: err}
+		}
+	default:
+		var cmd tea.Cmd
+		m.assignInput, cmd = m.assignInput.Update(msg)
+		return m, cmd
+	}
+}
+
+// updateWorklog handles keys while the log work prompt (L) is open. The first enter
+// validates the time spent, the second takes an optional remaining estimate and posts.
+func (m boardModel) updateWorklog(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc, tea.KeyCtrlC:
+		m.worklogKey = ""
+		m.statusMsg = ""
+		return m, nil
+	case tea.KeyEnter:
+		value := strings.TrimSpace(m.worklogInput.Value())
+		if m.worklogSpent == "" {
+			if _, _, err := jira.NewWorklog(value, ""); err != nil {
+				m.statusMsg = err.Error()
+				m.statusClearAt = time.Now().Add(30 * time.Second)
+				return m, nil
+			}
+			m.worklogSpent = value
+			m.statusMsg = ""
+			m.worklogInput.Placeholder = "e.g. 2h"
+			m.worklogInput.SetValue("")
+			return m, nil
+		}
+		if _, _, err := jira.NewWorklog(m.worklogSpent, value); err != nil {
+			m.statusMsg = err.Error()
+			m.statusClearAt = time.Now().Add(30 * time.Second)
+			return m, nil
+		}
+		key, spent := m.worklogKey, m.worklogSpent
+		m.worklogKey = ""
+		m.statusMsg = fmt.Sprintf("Logging %s on %s...", spent, key)
+		m.statusClearAt = time.Now().Add(30 * time.Second)
+		cfg := *m.cfg
+		return m, func() tea.Msg {
+			return worklogAddedMsg{key: key, spent: spent, err: logWork(&cfg, key, spent, value)}
+		}
+	default:
+		var cmd tea.Cmd
+		m.worklogInput, cmd = m.worklogInput.Update(msg)
+		return m, cmd
+	}
+}
+
+// updateBulkPrompt handles the bulk transition target prompt; enter starts transitioning
+// the marked issues one at a time
+func (m boardModel) updateBulkPrompt(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc, tea.KeyCtrlC:
+		m.bulkPrompt = false
+		return m, nil
+	case tea.KeyEnter:
+		target := strings.TrimSpace(m.bulkInput.Value())
+		if target == "" {
+			return m, nil
+		}
+		m.bulkPrompt = false
+		m.bulkTarget = target
+		m.bulkQueue = m.markedIssues()
+		m.bulkTotal = len(m.bulkQueue)
+		m.bulkMoved = 0
+		m.bulkProblems = nil
+		cmd := m.nextBulkTransition()
+		return m, cmd
+	default:
+		var cmd tea.Cmd
+		m.bulkInput, cmd = m.bulkInput.Update(msg)
+		return m, cmd
+	}
+}
+
+// markedIssues returns the marked issues ordered by key
+func (m boardModel) markedIssues() []JiraIssue {
+	issues := make([]JiraIssue, 0, len(m.marked))
+	for _, issue := range m.marked {
+		issues = append(issues, issue)
+	}
+	sort.Slice(issues, func(i, j int) bool { return issues[i].Key < issues[j].Key })
+	return issues
+}
+
+// nextBulkTransition pops the next queued issue, shows progress, and transitions it.
+// Issues go one at a time so partial failures are reported per issue.
+func (m *boardModel) nextBulkTransition() tea.Cmd {
+	issue := m.bulkQueue[0]
+	m.bulkQueue = m.bulkQueue[1:]
+	m.statusMsg = fmt.Sprintf("Transitioning %d/%d: %s → %s...", m.bulkTotal-len(m.bulkQueue), m.bulkTotal, issue.Key, m.bulkTarget)
+	m.statusClearAt = time.Now().Add(time.Minute)
+	cfg, target := *m.cfg, m.bulkTarget
+	return func() tea.Msg {
+		skipped, reason, err := transitionIssue(&cfg, issue, target)
+		return bulkTransitionMsg{issue: issue, skipped: skipped, reason: reason, err: err}
+	}
+}
+
+// reassignView renders the reassign prompt and, once a search returns, its matches
+func (m boardModel) reassignView() string {
+	view := fmt.Sprintf("Assign %s to: %s", m.assignKey, m.assignInput.View())
+	for i, u := range m.userMatches {
+		line := u.DisplayName
+		if u.EmailAddress != "" {
+			line += " <" + u.EmailAddress + ">"
+		}
+		if i == m.userCursor {
+			line = m.styles.selected.Render("> " + line)
+		} else {
+			line = "  " + line
+		}
+		view += "\n" + line
+	}
+	if len(m.userMatches) > 0 {
+		view += "\n" + m.styles.muted.Render("j/k select • enter assign • esc back")
+	}
+	return view
+}
+
+// applyAssignee updates the assignee of key everywhere it is cached, so the board
+// reflects a reassignment before the refresh lands
+func (m *boardModel) applyAssignee(key string, user jiraUser) {
+	set := func(issues []JiraIssue) {
+		for i := range issues {
+			if issues[i].Key == key {
+				issues[i].Fields.Assignee.AccountID = user.AccountID
+				issues[i].Fields.Assignee.DisplayName = user.DisplayName
+			}
+		}
+	}
+	for i := range m.columns {
+		c := &m.columns[i]
+		set(c.issues)
+		set(c.allIssues)
+		for _, issues := range c.allByScope {
+			set(issues)
+		}
+	}
+	if details, ok := m.issueDetails[key]; ok {
+		details.Fields.Assignee.AccountID = user.AccountID
+		details.Fields.Assignee.DisplayName = user.DisplayName
+		m.issueDetails[key] = details
+	}
+}
+
+// boardSearchJQL is the board's current query for JIRA's issue navigator: the projects,
+// the scope (or active preset), any priority:NAME filter, and the rest of the filter as a full-text search
+func boardSearchJQL(cfg *Config, scope scopeFilter, filter string) string {
+	predicates := []string{buildProjectFilter(cfg.Projects)}
+	if cfg.PresetJQL != "" {
+		where, _ := splitOrderBy(cfg.PresetJQL)
+		predicates = append(predicates, "("+where+")")
+	} else if scopePredicate := buildScopePredicate(scope); scopePredicate != "" {
+		predicates = append(predicates, scopePredicate)
+	}
+	priorities, filter := splitPriorityFilter(filter)
+	if len(priorities) > 0 {
+		quoted := make([]string, len(priorities))
+		for i, p := range priorities {
+			quoted[i] = fmt.Sprintf("%q", p)
+		}
+		predicates = append(predicates, "priority in ("+strings.Join(quoted, ", ")+")")
+	}
+	if text := strings.TrimSpace(filter); text != "" {
+		predicates = append(predicates, fmt.Sprintf("text ~ \"%s\"", strings.ReplaceAll(text, `"`, `\"`)))
+	}
+	return strings.Join(predicates, " AND ") + " ORDER BY updated DESC"
+}
+
+// copyToClipboard copies text and shows a short-lived footer status
+func (m *boardModel) copyToClipboard(text, successMsg string) tea.Cmd {
+	if err := clipboard.WriteAll(text); err != nil {
+		m.statusMsg = "Copy failed: " + err.Error()
+	} else {
+		m.statusMsg = successMsg
+	}
+	m.statusClearAt = time.Now().Add(2 * time.Second)
+	return tea.Tick(2*time.Second, func(t time.Time) tea.Msg {
+		return clearStatusMsg{}
+	})
+}
+
+// visibleIssueKeys returns the keys of every issue the board currently lists, column by
+// column, honoring the filter, tree and blocked views, and focus mode's hidden columns
+func (m boardModel) visibleIssueKeys() []string {
+	seen := make(map[string]bool)
+	var keys []string
+	for i, c := range m.columns {
+		if !m.columnVisible(i) {
+			continue
+		}
+		for _, it := range c.issues {
+			if !seen[it.Key] {
+				seen[it.Key] = true
+				keys = append(keys, it.Key)
+			}
+		}
+	}
+	return keys
+}
+
+// columnItemsWindow returns the number of issues that fit in a column, leaving room
+// for the detail rows of an expanded issue in that column
+func (m boardModel) columnItemsWindow(c kanbanColumnView, base int) int {
+	lines := base
+	if m.expandedKey != "" {
+		for _, it := range c.issues {
+			if it.Key == m.expandedKey {
+				lines -= expandedExtraLines
+				break
+			}
+		}
+	}
+	return max(1, lines/m.layout.rowHeight())
+}
+
+// relativeTime formats a JIRA timestamp as a short age like "5m ago" or "3d ago"
+func relativeTime(ts string, now time.Time) string {
+	t, err := time.Parse("2006-01-02T15:04:05.000-0700", ts)
+	if err != nil {
+		return ""
+	}
+	d := now.Sub(t)
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		return fmt.Sprintf("%dm ago", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh ago", int(d.Hours()))
+	default:
+		return fmt.Sprintf("%dd ago", int(d.Hours()/24))
+	}
+}
+
+// expandedDetailLines renders the inline detail rows for an expanded issue, preferring
+// on-demand details and falling back to whatever the list query returned
+func (m boardModel) expandedDetailLines(it JiraIssue) []string {
+	details, loaded := m.issueDetails[it.Key]
+	if !loaded {
+		details = it
+	}
+
+	orDash := func(s string) string {
+		if s == "" {
+			return "—"
+		}
+		return s
+	}
+	meta := fmt.Sprintf("   %s • @%s • P:%s",
+		orDash(details.Fields.Status.Name),
+		orDash(details.Fields.Assignee.DisplayName),
+		orDash(details.Fields.Priority.Name))
+
+	snippet := "(loading…)"
+	if loaded {
+		snippet = "(no description)"
+		if desc := strings.TrimSpace(extractDescriptionText(details)); desc != "" {
+			snippet = strings.SplitN(desc, "\n", 2)[0]
+		}
+	}
+	return []string{meta, "   " + snippet}
+}
+
 // ensureCursorVisible adjusts the column offset so that the cursor stays within the
 // visible window, honoring the up/down indicators.
 func (m boardModel) ensureCursorVisible(c *kanbanColumnView) {
@@ -1091,7 +3398,7 @@ func (m boardModel) ensureCursorVisible(c *kanbanColumnView) {
 	if c.cursor > len(c.issues)-1 {
 		c.cursor = len(c.issues) - 1
 	}
-	vh := m.itemsWindowCount()
+	vh := m.columnItemsWindow(*c, m.itemsWindowCount())
 	if c.cursor < c.offset {
 		c.offset = c.cursor
 	}
@@ -1154,59 +3461,74 @@ func scopeToConfigString(s scopeFilter) string {
 }
 
 func (m boardModel) saveUIPreferences() {
-	// Get current column widths if available
+	// Widths are saved only once adjusted with +/-, as the widths last set
 	var colWidths []int
-	if m.width > 0 {
-		usableWidth := m.width - 6
-		colWidths = []int{
-			int(float64(usableWidth) * 0.35), // To Do: 35%
-			int(float64(usableWidth) * 0.35), // In Progress: 35%
-			int(float64(usableWidth) * 0.30), // Done: 30%
-		}
-		// Ensure minimum widths
-		for i := range colWidths {
-			colWidths[i] = max(16, colWidths[i])
-		}
-	}
-
-	prefs := usercfg.UIPreferences{
-		LastScope:       scopeToConfigString(m.curScope),
-		ColumnWidths:    colWidths,
-		LastSelectedCol: m.selectedCol,
+	if len(m.columnWeights) == len(m.columns) {
+		colWidths = m.columnWeights
 	}
 
-	// Save preferences (ignore errors as this is best-effort)
-	_ = usercfg.SaveUIPrefs(prefs)
+	// Update only the fields the board owns so settings like fuzzy_search survive
+	// (ignore errors as this is best-effort)
+	_ = usercfg.UpdateUIPrefs(func(prefs *usercfg.UIPreferences) {
+		prefs.LastScope = scopeToConfigString(m.curScope)
+		prefs.ColumnWidths = colWidths
+		prefs.LastSelectedCol = m.selectedCol
+		prefs.ColumnOrder = m.columnTitles()
+		prefs.RowLayout = m.layout.configString()
+	})
 }
 
 func StartBoard(cfg *Config) error {
-	model := initialBoardModel(cfg)
-	p := tea.NewProgram(model, tea.WithAltScreen())
-	finalModel, err := p.Run()
+	for {
+		resolveStatusCategoryNames(cfg)
 
-	// Save UI preferences when the program exits
-	if bm, ok := finalModel.(boardModel); ok {
+		model := initialBoardModel(cfg)
+		opts := []tea.ProgramOption{tea.WithAltScreen()}
+		if cfg.RefreshOnFocus {
+			opts = append(opts, tea.WithReportFocus())
+		}
+		p := tea.NewProgram(model, opts...)
+		finalModel, err := p.Run()
+
+		// Save UI preferences when the program exits
+		bm, ok := finalModel.(boardModel)
+		if !ok {
+			return err
+		}
 		bm.saveUIPreferences()
 		if bm.launchSetup {
-			// Launch setup wizard synchronously after TUI exits
+			// Launch setup wizard synchronously after TUI exits, then re-read config
+			// and re-enter the board so new projects/boards take effect immediately
 			runSetup(nil, nil)
+			newCfg, loadErr := loadConfig()
+			if loadErr != nil {
+				return loadErr
+			}
+			cfg = newCfg
+			continue
+		}
+		if bm.pendingStart {
+			startIssueTransition(cfg, bm.pendingIssue)
 		}
 		// Spawn Claude in worktree/branch dir if Interactive Mode requested it
 		if bm.pendingClaude && bm.pendingWorktree != "" {
 			if err := spawnClaudeWithContext(bm.pendingWorktree, bm.pendingIssue); err != nil {
-				fmt.Fprintf(os.Stderr, "Error spawning Claude: %v\n", err)
+				dir, absErr := filepath.Abs(bm.pendingWorktree)
+				if absErr != nil {
+					dir = bm.pendingWorktree
+				}
+				fmt.Fprintf(os.Stderr, "\n\033[91mClaude exited with an error: %v\033[0m\n", err)
+				fmt.Fprintf(os.Stderr, "Your %s work is ready in %s; run claude there to try again.\n", bm.pendingIssue.Key, dir)
 				return err
 			}
 		}
+		return err
 	}
-
-	return err
 }
 
 // clip is a local helper similar to truncate but safe for narrow widths
-func getDefaultScope() scopeFilter {
-	config := usercfg.GetRuntimeConfig()
-	switch config.DefaultScope {
+func getDefaultScope(cfg *Config) scopeFilter {
+	switch cfg.DefaultScope {
 	case "assigned":
 		return scopeMine
 	case "reported":
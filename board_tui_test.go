@@ -2,7 +2,16 @@ package main
 
 import (
 	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"strings"
 	"testing"
+	"time"
+
+	"gci/internal/usercfg"
 
 	tea "github.com/charmbracelet/bubbletea"
 )
@@ -288,4 +297,1304 @@ func TestBoardModel_View_SmokeTest(t *testing.T) {
 	if len(view) == 0 {
 		t.Error("View() should return non-empty string when showing error")
 	}
-}
\ No newline at end of file
+}
+
+// TestBoardModel_ColumnReorder tests moving columns and restoring a saved order
+func TestBoardModel_ColumnReorder(t *testing.T) {
+	cfg := &Config{
+		JiraURL:  "https://test.atlassian.net",
+		Email:    "test@example.com",
+		APIToken: "test-token",
+		Projects: []string{"TEST"},
+	}
+
+	model := initialBoardModel(cfg)
+	model.selectedCol = 0
+
+	// Move "To Do" right, twice; the second move past the edge is a no-op
+	for _, key := range []string{">", ">", ">"} {
+		updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+		model = updated.(boardModel)
+	}
+
+	expected := []string{"In Progress", "Done", "To Do"}
+	for i, title := range model.columnTitles() {
+		if title != expected[i] {
+			t.Errorf("Column %d: expected %q, got %q", i, expected[i], title)
+		}
+	}
+	if model.selectedCol != 2 {
+		t.Errorf("Expected selection to follow the moved column to index 2, got %d", model.selectedCol)
+	}
+
+	// Background results are matched by title, not index
+	updated, _ := model.Update(lazyBatchLoadedMsg{
+		scope:    model.curScope,
+		byColumn: map[string][]JiraIssue{"To Do": {{Key: "TEST-1"}}},
+	})
+	model = updated.(boardModel)
+	if len(model.columns[2].issues) != 1 || model.columns[2].issues[0].Key != "TEST-1" {
+		t.Errorf("Expected To Do issues to land in the moved column, got %v", model.columns[2].issues)
+	}
+
+	// Saved order is applied; unknown titles are ignored and missing ones appended
+	restored := applyColumnOrder([]kanbanColumnView{
+		{title: "To Do"}, {title: "In Progress"}, {title: "Done"},
+	}, []string{"Done", "Bogus", "To Do"})
+	expected = []string{"Done", "To Do", "In Progress"}
+	for i, c := range restored {
+		if c.title != expected[i] {
+			t.Errorf("Restored column %d: expected %q, got %q", i, expected[i], c.title)
+		}
+	}
+}
+
+func TestBoardModel_ExpandIssueInline(t *testing.T) {
+	cfg := &Config{
+		JiraURL:  "https://test.atlassian.net",
+		Email:    "test@example.com",
+		APIToken: "test-token",
+		Projects: []string{"TEST"},
+	}
+
+	model := initialBoardModel(cfg)
+	model.selectedCol = 0
+	model.columns[0].issues = []JiraIssue{{Key: "TEST-1"}}
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+	model = updated.(boardModel)
+	if model.expandedKey != "TEST-1" {
+		t.Fatalf("Expected TEST-1 to be expanded, got %q", model.expandedKey)
+	}
+	if cmd == nil {
+		t.Error("Expected a details fetch command for an uncached issue")
+	}
+
+	// Loaded details are cached so re-expanding does not refetch
+	details := JiraIssue{Key: "TEST-1"}
+	details.Fields.Status.Name = "In Review"
+	updated, _ = model.Update(issueDetailsLoadedMsg{issue: details})
+	model = updated.(boardModel)
+	if lines := model.expandedDetailLines(model.columns[0].issues[0]); !strings.Contains(lines[0], "In Review") {
+		t.Errorf("Expected detail line to show loaded status, got %q", lines[0])
+	}
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+	model = updated.(boardModel)
+	if model.expandedKey != "" {
+		t.Errorf("Expected second space to collapse, got %q", model.expandedKey)
+	}
+	updated, cmd = model.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+	if cmd != nil {
+		t.Error("Expected cached details to skip the fetch")
+	}
+}
+
+func TestBoardModel_ScopeCycleDebounce(t *testing.T) {
+	cfg := &Config{
+		JiraURL:  "https://test.atlassian.net",
+		Email:    "test@example.com",
+		APIToken: "test-token",
+		Projects: []string{"TEST"},
+	}
+
+	model := initialBoardModel(cfg)
+
+	// Two quick presses: only the latest generation should fetch
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	model = updated.(boardModel)
+	if cmd == nil {
+		t.Fatal("Expected a debounce tick for an uncached scope")
+	}
+	first := scopeFetchMsg{gen: model.scopeGen, scope: model.curScope}
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	model = updated.(boardModel)
+
+	if _, cmd := model.Update(first); cmd != nil {
+		t.Error("Expected superseded scope fetch to be ignored")
+	}
+	updated, cmd = model.Update(scopeFetchMsg{gen: model.scopeGen, scope: model.curScope})
+	model = updated.(boardModel)
+	if cmd == nil {
+		t.Error("Expected settled scope to fetch")
+	}
+	if model.scopeCancel == nil {
+		t.Error("Expected in-flight fetch to be cancellable")
+	}
+	model.scopeCancel()
+
+	// Cached scopes switch without scheduling a fetch
+	for i := range model.columns {
+		model.columns[i].allByScope = map[scopeFilter][]JiraIssue{
+			scopeMineOrReported: nil, scopeMine: nil, scopeReported: nil, scopeUnassigned: nil,
+		}
+	}
+	if _, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")}); cmd != nil {
+		t.Error("Expected cached scope switch to be instant")
+	}
+}
+
+func TestBoardModel_HierarchyToggle(t *testing.T) {
+	cfg := &Config{
+		JiraURL:  "https://test.atlassian.net",
+		Email:    "test@example.com",
+		APIToken: "test-token",
+		Projects: []string{"TEST"},
+	}
+
+	parent := JiraIssue{Key: "TEST-1"}
+	sub := JiraIssue{Key: "TEST-3"}
+	sub.Fields.IssueType.Subtask = true
+	sub.Fields.Parent.Key = "TEST-1"
+	other := JiraIssue{Key: "TEST-2"}
+
+	model := initialBoardModel(cfg)
+	model.columns[1].allIssues = []JiraIssue{sub, other, parent}
+	model.columns[1].issues = model.filterAndGroupColumn(model.columns[1].title, model.columns[1].allIssues, "")
+
+	keys := func() []string {
+		var out []string
+		for _, it := range model.columns[1].issues {
+			out = append(out, it.Key)
+		}
+		return out
+	}
+
+	expected := [][]string{
+		{"TEST-3", "TEST-2", "TEST-1"}, // flat: original order
+		{"TEST-2", "TEST-1"},           // parents only
+		{"TEST-2", "TEST-1", "TEST-3"}, // grouped again: subtask under parent
+	}
+	for i, want := range expected {
+		updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+		model = updated.(boardModel)
+		got := keys()
+		if strings.Join(got, ",") != strings.Join(want, ",") {
+			t.Errorf("Toggle %d (%s): expected %v, got %v", i+1, model.hierarchy, want, got)
+		}
+	}
+}
+
+func TestBoardModel_QuickCreate(t *testing.T) {
+	cfg := &Config{
+		JiraURL:          "https://test.atlassian.net",
+		Email:            "test@example.com",
+		APIToken:         "test-token",
+		Projects:         []string{"TEST", "OPS"},
+		DefaultProject:   "OPS",
+		DefaultIssueType: "Task",
+	}
+
+	if got := quickCreateProject(cfg); got != "OPS" {
+		t.Errorf("Expected quick create to use default project OPS, got %q", got)
+	}
+
+	model := initialBoardModel(cfg)
+	model.curScope = scopeMine
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("C")})
+	model = updated.(boardModel)
+	if !model.creating {
+		t.Fatal("Expected C to open the quick create prompt")
+	}
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	model = updated.(boardModel)
+	if model.creating {
+		t.Error("Expected esc to cancel quick create")
+	}
+
+	created := JiraIssue{Key: "OPS-42"}
+	created.Fields.Summary = "Rotate certs"
+	updated, _ = model.Update(issueCreatedMsg{issue: created})
+	model = updated.(boardModel)
+
+	todo := model.columns[model.columnIndex("To Do")]
+	if len(todo.issues) != 1 || todo.issues[0].Key != "OPS-42" {
+		t.Errorf("Expected created issue at the top of To Do, got %v", todo.issues)
+	}
+	if model.statusMsg != "Created OPS-42" {
+		t.Errorf("Expected status 'Created OPS-42', got %q", model.statusMsg)
+	}
+}
+
+func TestBoardModel_AllStatuses(t *testing.T) {
+	cfg := &Config{
+		JiraURL:     "https://test.atlassian.net",
+		Email:       "test@example.com",
+		APIToken:    "test-token",
+		Projects:    []string{"TEST"},
+		AllStatuses: true,
+	}
+
+	model := initialBoardModel(cfg)
+	idx := model.columnIndex("Other")
+	if len(model.columns) != 4 || idx < 0 {
+		t.Fatalf("Expected an Other column, got %v", model.columnTitles())
+	}
+
+	// Four columns must render without assuming the fixed three-column widths
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 120, Height: 30})
+	model = updated.(boardModel)
+	model.loading = false
+	_ = model.View()
+
+	expected := `statusCategory not in ("To Do", "In Progress", "Done")`
+	if got := statusCategoryPredicate(cfg, model.columns[idx].statusCategory); got != expected {
+		t.Errorf("Expected %s, got %s", expected, got)
+	}
+}
+
+func TestBoardModel_TreeFilter(t *testing.T) {
+	cfg := &Config{
+		JiraURL:  "https://test.atlassian.net",
+		Email:    "test@example.com",
+		APIToken: "test-token",
+		Projects: []string{"TEST"},
+	}
+
+	epic := JiraIssue{Key: "TEST-1"}
+	story := JiraIssue{Key: "TEST-2"}
+	story.Fields.Parent.Key = "TEST-1"
+	sub := JiraIssue{Key: "TEST-3"}
+	sub.Fields.IssueType.Subtask = true
+	sub.Fields.Parent.Key = "TEST-2"
+	unrelated := JiraIssue{Key: "TEST-4"}
+
+	model := initialBoardModel(cfg)
+	model.selectedCol = 0
+	model.columns[0].allIssues = []JiraIssue{epic, unrelated}
+	model.columns[1].allIssues = []JiraIssue{story, sub}
+	for i := range model.columns {
+		model.columns[i].issues = model.filterAndGroupColumn(model.columns[i].title, model.columns[i].allIssues, "")
+	}
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+	model = updated.(boardModel)
+	if model.treeRoot != "TEST-1" {
+		t.Fatalf("Expected tree filter on TEST-1, got %q", model.treeRoot)
+	}
+	if len(model.columns[0].issues) != 1 || model.columns[0].issues[0].Key != "TEST-1" {
+		t.Errorf("Expected only the epic in To Do, got %v", model.columns[0].issues)
+	}
+	if len(model.columns[1].issues) != 2 {
+		t.Errorf("Expected child and grandchild in In Progress, got %v", model.columns[1].issues)
+	}
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+	model = updated.(boardModel)
+	if model.treeRoot != "" || len(model.columns[0].issues) != 2 {
+		t.Errorf("Expected f to clear the tree filter, got root %q and %v", model.treeRoot, model.columns[0].issues)
+	}
+}
+
+func TestBoardModel_Reassign(t *testing.T) {
+	cfg := &Config{
+		JiraURL:  "https://test.atlassian.net",
+		Email:    "test@example.com",
+		APIToken: "test-token",
+		Projects: []string{"TEST"},
+	}
+
+	model := initialBoardModel(cfg)
+	model.loading = false
+	model.curScope = scopeReported
+	issue := JiraIssue{Key: "TEST-1"}
+	issue.Fields.Assignee.DisplayName = "Ada"
+	model.columns[0].allIssues = []JiraIssue{issue}
+	model.columns[0].issues = []JiraIssue{issue}
+	model.selectedCol = 0
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("A")})
+	model = updated.(boardModel)
+	if model.assignKey != "TEST-1" {
+		t.Fatalf("Expected A to open the reassign prompt for TEST-1, got %q", model.assignKey)
+	}
+
+	users := []jiraUser{{AccountID: "1", DisplayName: "Grace"}, {AccountID: "2", DisplayName: "Linus"}}
+	updated, _ = model.Update(usersFoundMsg{users: users})
+	model = updated.(boardModel)
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	model = updated.(boardModel)
+	if model.userCursor != 1 {
+		t.Errorf("Expected j to move the user cursor, got %d", model.userCursor)
+	}
+	if !strings.Contains(model.View(), "Assign TEST-1 to") {
+		t.Error("Expected the reassign prompt in the view")
+	}
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(boardModel)
+	if cmd == nil || model.assignKey != "" {
+		t.Fatal("Expected enter to close the picker and start the assignment")
+	}
+
+	updated, _ = model.Update(issueAssignedMsg{key: "TEST-1", user: users[1]})
+	model = updated.(boardModel)
+	if got := model.columns[0].issues[0].Fields.Assignee.DisplayName; got != "Linus" {
+		t.Errorf("Expected assignee to be updated to Linus, got %q", got)
+	}
+	if got := model.columns[0].issues[0].Fields.Assignee.AccountID; got != "2" {
+		t.Errorf("Expected the assignee's account ID to follow, so the badge recolors; got %q", got)
+	}
+	if model.statusMsg != "Assigned TEST-1 to Linus" {
+		t.Errorf("Unexpected status %q", model.statusMsg)
+	}
+	if model.loading {
+		t.Error("Reported scope is unaffected by reassignment and should not refresh")
+	}
+}
+
+func TestBoardModel_LogWorkPrompt(t *testing.T) {
+	cfg := &Config{JiraURL: "https://test.atlassian.net", Projects: []string{"TEST"}}
+	model := initialBoardModel(cfg)
+	model.loading = false
+	issue := JiraIssue{Key: "TEST-1"}
+	model.columns[0].allIssues = []JiraIssue{issue}
+	model.columns[0].issues = []JiraIssue{issue}
+	model.selectedCol = 0
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("L")})
+	model = updated.(boardModel)
+	if model.worklogKey != "TEST-1" {
+		t.Fatalf("Expected L to open the log work prompt, got %q", model.worklogKey)
+	}
+
+	model.worklogInput.SetValue("soon")
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(boardModel)
+	if model.worklogSpent != "" || model.statusMsg == "" {
+		t.Error("Expected an invalid duration to be rejected with a message")
+	}
+
+	model.worklogInput.SetValue("1h 30m")
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(boardModel)
+	if model.worklogSpent != "1h 30m" {
+		t.Fatalf("Expected time spent to be accepted, got %q", model.worklogSpent)
+	}
+	if !strings.Contains(model.View(), "Remaining estimate for TEST-1") {
+		t.Error("Expected the remaining estimate prompt")
+	}
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = updated.(boardModel)
+	if cmd == nil || model.worklogKey != "" {
+		t.Error("Expected enter on the remaining prompt to submit the worklog")
+	}
+}
+
+func TestBoardModel_RowLayoutCycle(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	cfg := &Config{JiraURL: "https://test.atlassian.net", Projects: []string{"TEST"}}
+	model := initialBoardModel(cfg)
+	model.loading = false
+	model.width, model.height = 120, 30
+	issue := JiraIssue{Key: "TEST-1"}
+	issue.Fields.Summary = "Dense rows"
+	issue.Fields.Status.Name = "In Review"
+	issue.Fields.Assignee.DisplayName = "Ada Lovelace"
+	issue.Fields.Updated = time.Now().Add(-3 * time.Hour).Format("2006-01-02T15:04:05.000-0700")
+	model.columns[0].allIssues = []JiraIssue{issue}
+	model.columns[0].issues = []JiraIssue{issue}
+	model.columns[0].allByScope = map[scopeFilter][]JiraIssue{model.curScope: {issue}}
+
+	if model.layout != rowCompact {
+		t.Fatalf("Expected compact layout by default, got %v", model.layout)
+	}
+	base := model.itemsWindowCount()
+	if strings.Contains(model.View(), "@Ada") {
+		t.Error("Compact layout should not show assignee tags")
+	}
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("z")})
+	model = updated.(boardModel)
+	if model.layout != rowNormal || !strings.Contains(model.View(), "@Ada") {
+		t.Error("Expected normal layout to show assignee tags")
+	}
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("z")})
+	model = updated.(boardModel)
+	model.loading = false
+	if !strings.Contains(model.View(), "In Review • updated 3h ago") {
+		t.Error("Expected detailed layout to show status and last update")
+	}
+	if got := model.columnItemsWindow(model.columns[0], base); got != base/2 {
+		t.Errorf("Expected detailed rows to halve the window (%d), got %d", base/2, got)
+	}
+
+	if prefs := usercfg.GetUIPrefs(); prefs.RowLayout != "detailed" {
+		t.Errorf("Expected row_layout to be saved, got %q", prefs.RowLayout)
+	}
+}
+
+// TestReorderAndGroupIssues_SeparateBacklog checks backlog issues sink below active ones only when asked
+func TestReorderAndGroupIssues_SeparateBacklog(t *testing.T) {
+	mk := func(key, status string) JiraIssue {
+		var it JiraIssue
+		it.Key = key
+		it.Fields.Status.Name = status
+		return it
+	}
+	issues := []JiraIssue{mk("T-1", "Backlog"), mk("T-2", "In Review"), mk("T-3", "Backlog"), mk("T-4", "Blocked")}
+	keys := func(in []JiraIssue) string {
+		out := make([]string, len(in))
+		for i, it := range in {
+			out[i] = it.Key
+		}
+		return strings.Join(out, ",")
+	}
+
+	if got := keys(reorderAndGroupIssues(issues, true)); got != "T-2,T-4,T-1,T-3" {
+		t.Errorf("separated order = %s, want T-2,T-4,T-1,T-3", got)
+	}
+	if got := keys(reorderAndGroupIssues(issues, false)); got != "T-1,T-2,T-3,T-4" {
+		t.Errorf("unseparated order = %s, want original order", got)
+	}
+
+	cases := []struct {
+		mode, title string
+		want        bool
+	}{
+		{"", "To Do", true},
+		{"", "In Progress", false},
+		{separateBacklogTodoOnly, "To Do", true},
+		{separateBacklogOff, "To Do", false},
+		{separateBacklogAll, "In Progress", true},
+	}
+	for _, tc := range cases {
+		if got := separatesBacklog(tc.mode, tc.title); got != tc.want {
+			t.Errorf("separatesBacklog(%q, %q) = %v, want %v", tc.mode, tc.title, got, tc.want)
+		}
+	}
+}
+
+// TestBoardModel_SingleColumnLayout checks narrow terminals render only the selected column
+func TestBoardModel_SingleColumnLayout(t *testing.T) {
+	cfg := &Config{
+		JiraURL:  "https://test.atlassian.net",
+		Email:    "test@example.com",
+		APIToken: "test-token",
+		Projects: []string{"TEST"},
+	}
+
+	model := initialBoardModel(cfg)
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 60, Height: 24})
+	model = updated.(boardModel)
+	if !model.singleColumn {
+		t.Fatal("expected single-column layout below the width threshold")
+	}
+
+	view := model.View()
+	if !strings.Contains(view, "(1/3") || strings.Contains(view, "In Progress") {
+		t.Errorf("narrow view should show only To Do, got:\n%s", view)
+	}
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRight})
+	model = updated.(boardModel)
+	view = model.View()
+	if !strings.Contains(view, "In Progress") || !strings.Contains(view, "(2/3") {
+		t.Errorf("right should switch to In Progress, got:\n%s", view)
+	}
+
+	updated, _ = model.Update(tea.WindowSizeMsg{Width: 140, Height: 24})
+	model = updated.(boardModel)
+	if model.singleColumn {
+		t.Error("wide terminal should use the multi-column layout")
+	}
+	if view := model.View(); !strings.Contains(view, "To Do") || !strings.Contains(view, "Done") {
+		t.Error("wide view should show every column")
+	}
+}
+
+// TestBoardSearchJQL checks the O key's query mirrors the board's projects, scope, and filter
+func TestBoardSearchJQL(t *testing.T) {
+	cfg := &Config{JiraURL: "https://test.atlassian.net", Projects: []string{"INF", "OPS"}}
+
+	got := boardSearchJQL(cfg, scopeMine, ` login "bug" `)
+	want := `project in (INF, OPS) AND assignee = currentUser() AND text ~ "login \"bug\"" ORDER BY updated DESC`
+	if got != want {
+		t.Errorf("boardSearchJQL = %s, want %s", got, want)
+	}
+
+	if got := boardSearchJQL(cfg, scopeUnassigned, ""); got != "project in (INF, OPS) AND assignee is EMPTY ORDER BY updated DESC" {
+		t.Errorf("unfiltered JQL = %s", got)
+	}
+
+	if got := issueSearchURL(cfg, "project = INF"); got != "https://test.atlassian.net/issues/?jql=project+%3D+INF" {
+		t.Errorf("issueSearchURL = %s", got)
+	}
+
+	model := initialBoardModel(cfg)
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'O'}})
+	model = updated.(boardModel)
+	if !strings.HasPrefix(model.statusMsg, "JQL: project in (INF, OPS)") || model.pendingSearch == "" {
+		t.Errorf("first O should show the JQL, got status %q", model.statusMsg)
+	}
+}
+
+// TestBoardModel_FocusMode checks F hides Done and empty columns and navigation skips them
+func TestBoardModel_FocusMode(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	cfg := &Config{
+		JiraURL:  "https://test.atlassian.net",
+		Email:    "test@example.com",
+		APIToken: "test-token",
+		Projects: []string{"TEST"},
+	}
+
+	model := initialBoardModel(cfg)
+	model.width, model.height = 140, 30
+	var issue JiraIssue
+	issue.Key = "TEST-1"
+	issue.Fields.Summary = "Active work"
+	for i := range model.columns {
+		model.columns[i].allByScope = map[scopeFilter][]JiraIssue{model.curScope: nil}
+	}
+	model.columns[0].issues = []JiraIssue{issue}
+	model.columns[0].allByScope[model.curScope] = model.columns[0].issues
+	model.columns[2].issues = []JiraIssue{issue}
+	model.columns[2].allByScope[model.curScope] = model.columns[2].issues
+	model.selectedCol = 2 // Done
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'F'}})
+	model = updated.(boardModel)
+	if !model.focusMode {
+		t.Fatal("F should enable focus mode")
+	}
+	if model.selectedCol != 0 {
+		t.Errorf("selection should leave hidden Done for To Do, got column %d", model.selectedCol)
+	}
+	if model.columnVisible(1) || model.columnVisible(2) {
+		t.Error("empty In Progress and Done should be hidden")
+	}
+	if view := model.View(); strings.Contains(view, "In Progress") {
+		t.Errorf("focus view should not draw hidden columns:\n%s", view)
+	}
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRight})
+	model = updated.(boardModel)
+	if model.selectedCol != 0 {
+		t.Errorf("navigation should skip hidden columns, got column %d", model.selectedCol)
+	}
+	if !usercfg.GetUIPrefs().FocusMode {
+		t.Error("focus mode should be saved to UI preferences")
+	}
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'F'}})
+	model = updated.(boardModel)
+	if !model.columnVisible(1) || !model.columnVisible(2) {
+		t.Error("turning focus mode off should show every column")
+	}
+}
+
+// TestBoardModel_BulkTransition marks two issues and transitions them, one lacking the transition
+func TestBoardModel_BulkTransition(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	var posted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/rest/api/3/issue/TEST-1/transitions":
+			w.Write([]byte(`{"transitions":[{"id":"31","name":"Send to QA","to":{"name":"Ready for QA"}}]}`))
+		case r.Method == "GET" && r.URL.Path == "/rest/api/3/issue/TEST-2/transitions":
+			w.Write([]byte(`{"transitions":[]}`))
+		case r.Method == "POST" && strings.HasSuffix(r.URL.Path, "/transitions"):
+			posted = append(posted, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	cfg := &Config{JiraURL: server.URL, Email: "test@example.com", APIToken: "test-token", Projects: []string{"TEST"}}
+	model := initialBoardModel(cfg)
+	model.selectedCol = 0
+	for _, key := range []string{"TEST-1", "TEST-2"} {
+		var issue JiraIssue
+		issue.Key = key
+		issue.Fields.Status.Name = "In Review"
+		model.columns[0].issues = append(model.columns[0].issues, issue)
+	}
+
+	press := func(msg tea.KeyMsg) tea.Cmd {
+		updated, cmd := model.Update(msg)
+		model = updated.(boardModel)
+		return cmd
+	}
+	press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'v'}})
+	press(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	press(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	if len(model.marked) != 2 {
+		t.Fatalf("expected 2 marked issues, got %d", len(model.marked))
+	}
+
+	press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'T'}})
+	if !model.bulkPrompt {
+		t.Fatal("T should open the bulk transition prompt")
+	}
+	press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Ready for QA")})
+	cmd := press(tea.KeyMsg{Type: tea.KeyEnter})
+	if !strings.HasPrefix(model.statusMsg, "Transitioning 1/2: TEST-1") {
+		t.Errorf("expected progress in the footer, got %q", model.statusMsg)
+	}
+
+	// First issue moves, second lacks the transition; each result triggers the next
+	updated, cmd := model.Update(cmd())
+	model = updated.(boardModel)
+	if !strings.HasPrefix(model.statusMsg, "Transitioning 2/2: TEST-2") {
+		t.Errorf("expected progress for the second issue, got %q", model.statusMsg)
+	}
+	updated, _ = model.Update(cmd())
+	model = updated.(boardModel)
+
+	if len(posted) != 1 || posted[0] != "/rest/api/3/issue/TEST-1/transitions" {
+		t.Errorf("expected only TEST-1 to be transitioned, posted %v", posted)
+	}
+	if !strings.HasPrefix(model.statusMsg, "Moved 1/2 to Ready for QA") || !strings.Contains(model.statusMsg, "TEST-2") {
+		t.Errorf("expected a summary naming the skipped issue, got %q", model.statusMsg)
+	}
+	if model.selecting || len(model.marked) != 0 || !model.loading {
+		t.Error("expected marks cleared and a refresh after the run")
+	}
+}
+
+// TestFilterAndGroupColumn_KeyPrefixFirst checks a key fragment outranks fuzzy summary hits
+func TestFilterAndGroupColumn_KeyPrefixFirst(t *testing.T) {
+	cfg := &Config{JiraURL: "https://test.atlassian.net", Projects: []string{"INF"}}
+	model := initialBoardModel(cfg)
+
+	mk := func(key, summary string) JiraIssue {
+		var it JiraIssue
+		it.Key = key
+		it.Fields.Summary = summary
+		return it
+	}
+	issues := []JiraIssue{
+		mk("INF-9", "Bump 123 dependencies"),
+		mk("INF-51", "Raise limit from 1 to 23"),
+		mk("INF-123", "Unrelated title"),
+	}
+
+	got := model.filterAndGroupColumn("In Progress", issues, "123")
+	if len(got) == 0 || got[0].Key != "INF-123" {
+		t.Fatalf("expected INF-123 first, got %v", got)
+	}
+
+	// With summaries weighted far above keys, only the prefix rule keeps INF-123 on top
+	cfg.FilterSummaryWeight = 10
+	got = model.filterAndGroupColumn("In Progress", issues, "123")
+	if got[0].Key != "INF-123" || got[1].Key != "INF-9" {
+		t.Errorf("expected INF-123 then the exact summary hit, got %s, %s", got[0].Key, got[1].Key)
+	}
+}
+
+func TestFilterAndGroupColumn_Priority(t *testing.T) {
+	cfg := &Config{JiraURL: "https://test.atlassian.net", Projects: []string{"INF"}}
+	model := initialBoardModel(cfg)
+
+	mk := func(key, summary, priority string) JiraIssue {
+		var it JiraIssue
+		it.Key = key
+		it.Fields.Summary = summary
+		it.Fields.Priority.Name = priority
+		return it
+	}
+	issues := []JiraIssue{
+		mk("INF-1", "Login outage", "Critical"),
+		mk("INF-2", "Login copy tweak", "Low"),
+		mk("INF-3", "Disk alerts", "High"),
+	}
+
+	got := model.filterAndGroupColumn("To Do", issues, "Priority:high,critical")
+	if len(got) != 2 || got[0].Key != "INF-1" || got[1].Key != "INF-3" {
+		t.Errorf("priority filter should keep INF-1 and INF-3, got %v", got)
+	}
+	got = model.filterAndGroupColumn("To Do", issues, "login priority:critical")
+	if len(got) != 1 || got[0].Key != "INF-1" {
+		t.Errorf("priority and text filters should combine, got %v", got)
+	}
+
+	if jql := boardSearchJQL(cfg, scopeMine, "login priority:high"); jql != `project = INF AND assignee = currentUser() AND priority in ("high") AND text ~ "login" ORDER BY updated DESC` {
+		t.Errorf("boardSearchJQL = %s", jql)
+	}
+}
+
+func TestBoardModel_JQLPresets(t *testing.T) {
+	cfg := &Config{
+		JiraURL:  "https://test.atlassian.net",
+		Projects: []string{"INF"},
+		JQLPresets: map[string]string{
+			"Needs Review": `status = "In Review" ORDER BY priority DESC`,
+			"My Sprint":    "sprint in openSprints() AND assignee = currentUser()",
+		},
+	}
+	model := initialBoardModel(cfg)
+
+	press := func(r rune) {
+		updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		model = updated.(boardModel)
+	}
+	press('p')
+	if model.preset != "My Sprint" || !model.loading {
+		t.Fatalf("first p should load the first preset, got %q (loading %v)", model.preset, model.loading)
+	}
+	if got := columnJQL(model.cfg, "To Do", model.curScope); got != `project = INF AND statusCategory = "To Do" AND (sprint in openSprints() AND assignee = currentUser()) ORDER BY updated DESC` {
+		t.Errorf("preset column JQL = %s", got)
+	}
+	if !strings.Contains(model.View(), "Preset: My Sprint") {
+		t.Error("header should name the active preset")
+	}
+
+	press('p')
+	if got := columnJQL(model.cfg, "Done", model.curScope); got != `project = INF AND statusCategory = "Done" AND (status = "In Review") ORDER BY priority DESC` {
+		t.Errorf("preset ORDER BY should be kept, got %s", got)
+	}
+	scope := model.curScope
+	press('s')
+	if model.curScope != scope {
+		t.Error("s should not change the scope while a preset is active")
+	}
+
+	press('p')
+	if model.preset != "" || model.cfg.PresetJQL != "" {
+		t.Errorf("p after the last preset should return to the scope, got %q", model.preset)
+	}
+}
+
+func TestBoardModel_UpdateCheckOverlay(t *testing.T) {
+	cfg := &Config{
+		JiraURL:  "https://test.atlassian.net",
+		Projects: []string{"TEST"},
+	}
+	origCheck := boardUpdateCheck
+	defer func() { boardUpdateCheck = origCheck }()
+	boardUpdateCheck = func() string { return "v9.9.9" }
+
+	model := initialBoardModel(cfg)
+	model.width, model.height = 120, 30
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'U'}})
+	model = updated.(boardModel)
+	if model.statusMsg != "Checking for updates..." || cmd == nil {
+		t.Fatalf("U should start a check, status %q", model.statusMsg)
+	}
+
+	updated, _ = model.Update(cmd())
+	model = updated.(boardModel)
+	if model.updateVersion != "v9.9.9" {
+		t.Fatalf("updateVersion = %q, want v9.9.9", model.updateVersion)
+	}
+	view := model.View()
+	if !strings.Contains(view, "v9.9.9") || !strings.Contains(view, "gci update") {
+		t.Errorf("overlay should name the new version and gci update:\n%s", view)
+	}
+
+	// any key closes the overlay without acting on the board
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+	model = updated.(boardModel)
+	if model.updateVersion != "" {
+		t.Error("a key press should dismiss the overlay")
+	}
+
+	updated, _ = model.Update(updateCheckedMsg{})
+	model = updated.(boardModel)
+	if model.updateVersion != "" || model.statusMsg == "" {
+		t.Errorf("no update should leave a footer note, got overlay %q status %q", model.updateVersion, model.statusMsg)
+	}
+}
+
+func TestColumnCategoryWarnings(t *testing.T) {
+	columns := []kanbanColumnView{
+		{title: "To Do", statusCategory: "Aufgabn"},
+		{title: "In Progress", statusCategory: "in arbeit"},
+		{title: "Done", statusCategory: "Fertig"},
+		{title: "Other", statusCategory: otherStatusCategory},
+	}
+	instance := map[string]string{"new": "Aufgaben", "indeterminate": "In Arbeit", "done": "Fertig"}
+
+	warnings := columnCategoryWarnings(columns, instance)
+	if len(warnings) != 1 {
+		t.Fatalf("expected one warning for the misspelled To Do category, got %v", warnings)
+	}
+	if !strings.Contains(warnings[0], `"Aufgabn"`) || !strings.Contains(warnings[0], "Aufgaben, In Arbeit, Fertig") {
+		t.Errorf("warning should name the bad category and the valid ones: %s", warnings[0])
+	}
+
+	model := initialBoardModel(&Config{JiraURL: "https://test.atlassian.net", Projects: []string{"TEST"}})
+	model.width, model.height = 120, 30
+	updated, _ := model.Update(columnCheckMsg{warnings: warnings})
+	if view := updated.(boardModel).View(); !strings.Contains(view, "Aufgabn") {
+		t.Errorf("warning should show in the footer:\n%s", view)
+	}
+}
+
+func TestBoardModel_BlockedOnly(t *testing.T) {
+	data := `[
+		{"key":"TEST-1","fields":{"summary":"Flagged","status":{"name":"In Progress"},"customfield_10021":[{"value":"Impediment"}]}},
+		{"key":"TEST-2","fields":{"summary":"Waiting on vendor","status":{"name":"Blocked"},"customfield_10021":null}},
+		{"key":"TEST-3","fields":{"summary":"Moving along","status":{"name":"In Progress"},"customfield_10021":[]}}
+	]`
+	issues := decodeTestIssues(t, &Config{FlaggedField: "customfield_10021"}, data)
+	if !isBlockedIssue(issues[0]) || !isBlockedIssue(issues[1]) || isBlockedIssue(issues[2]) {
+		t.Fatalf("expected TEST-1 (flagged) and TEST-2 (Blocked status) to be blocked: %+v", issues)
+	}
+
+	model := initialBoardModel(&Config{JiraURL: "https://test.atlassian.net", Projects: []string{"TEST"}})
+	model.width, model.height = 140, 30
+	model.columns[1].allIssues = issues
+	model.columns[1].issues = issues
+	if view := model.View(); !strings.Contains(view, "⚑") {
+		t.Errorf("blocked issues should carry a marker:\n%s", view)
+	}
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'B'}})
+	model = updated.(boardModel)
+	if got := len(model.columns[1].issues); got != 2 {
+		t.Errorf("B should keep the 2 blocked issues, got %d", got)
+	}
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'B'}})
+	if got := len(updated.(boardModel).columns[1].issues); got != 3 {
+		t.Errorf("B again should show all issues, got %d", got)
+	}
+}
+
+func TestBoardModel_VisibleIssueKeys(t *testing.T) {
+	model := initialBoardModel(&Config{JiraURL: "https://test.atlassian.net", Projects: []string{"TEST"}})
+	issue := func(key, summary string) JiraIssue {
+		var it JiraIssue
+		it.Key = key
+		it.Fields.Summary = summary
+		return it
+	}
+	model.columns[0].allIssues = []JiraIssue{issue("TEST-1", "Login page"), issue("TEST-2", "Billing export")}
+	model.columns[1].allIssues = []JiraIssue{issue("TEST-3", "Login rate limit")}
+	model.columns[2].allIssues = []JiraIssue{issue("TEST-4", "Login audit")}
+	model.filter = "login"
+	model.regroupColumns()
+
+	if got := strings.Join(model.visibleIssueKeys(), ","); got != "TEST-1,TEST-3,TEST-4" {
+		t.Errorf("visible keys = %s, want the filtered issues in column order", got)
+	}
+
+	model.focusMode = true // hides Done
+	if got := strings.Join(model.visibleIssueKeys(), ","); got != "TEST-1,TEST-3" {
+		t.Errorf("visible keys in focus mode = %s", got)
+	}
+}
+
+func TestBoardModel_RefreshOnFocus(t *testing.T) {
+	model := initialBoardModel(&Config{JiraURL: "https://test.atlassian.net", Projects: []string{"TEST"}})
+	if _, cmd := model.Update(tea.FocusMsg{}); cmd != nil {
+		t.Error("focus should not reload without refresh_on_focus")
+	}
+
+	model.cfg.RefreshOnFocus = true
+	model.loading = false
+	model.loadedAt = time.Now()
+	if _, cmd := model.Update(tea.FocusMsg{}); cmd != nil {
+		t.Error("focus right after a load should not reload")
+	}
+
+	model.loadedAt = time.Now().Add(-focusRefreshInterval)
+	updated, cmd := model.Update(tea.FocusMsg{})
+	if cmd == nil || !updated.(boardModel).loading {
+		t.Error("focus after the interval should reload")
+	}
+	if _, cmd := updated.Update(tea.FocusMsg{}); cmd != nil {
+		t.Error("focus while loading should not start another load")
+	}
+}
+
+func TestBoardModel_ReloadKeepsCursorOnIssue(t *testing.T) {
+	model := initialBoardModel(&Config{JiraURL: "https://test.atlassian.net", Projects: []string{"TEST"}})
+	model.width, model.height = 140, 30
+	col := &model.columns[1]
+	col.issues = []JiraIssue{{Key: "TEST-1"}, {Key: "TEST-2"}, {Key: "TEST-3"}}
+	col.cursor = 1
+
+	reloaded := make([]kanbanColumnView, len(model.columns))
+	copy(reloaded, model.columns)
+	reloaded[1].issues = []JiraIssue{{Key: "TEST-4"}, {Key: "TEST-1"}, {Key: "TEST-3"}, {Key: "TEST-2"}}
+	updated, _ := model.Update(dataLoadedMsg{columns: reloaded})
+	if got := updated.(boardModel).columns[1].cursor; got != 3 {
+		t.Errorf("cursor should follow TEST-2 to index 3, got %d", got)
+	}
+}
+
+func TestBoardModel_HideIssues(t *testing.T) {
+	model := initialBoardModel(&Config{JiraURL: "https://test.atlassian.net", Projects: []string{"TEST"}})
+	model.width, model.height = 140, 30
+	model.loading = false
+	issues := []JiraIssue{{Key: "TEST-1"}, {Key: "TEST-2"}, {Key: "TEST-3"}}
+	model.columns[1].allIssues = issues
+	model.columns[1].issues = issues
+	model.selectedCol = 1
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	model = updated.(boardModel)
+	if got := model.columns[1].issues; len(got) != 1 || got[0].Key != "TEST-3" {
+		t.Fatalf("x twice should hide TEST-1 and TEST-2, got %v", got)
+	}
+	model.statusMsg = ""
+	if view := model.View(); !strings.Contains(view, "(2 hidden") {
+		t.Errorf("footer should count hidden issues:\n%s", view)
+	}
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+	if len(updated.(boardModel).hidden) != 2 {
+		t.Error("hidden issues should survive a refresh by default")
+	}
+	model.cfg.UnhideOnRefresh = true
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+	if len(updated.(boardModel).hidden) != 0 {
+		t.Error("unhide_on_refresh should clear hidden issues on refresh")
+	}
+
+	model.hidden["TEST-1"] = true
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'X'}})
+	if got := len(updated.(boardModel).columns[1].issues); got != 3 {
+		t.Errorf("X should show every issue again, got %d", got)
+	}
+}
+
+func TestBoardModel_ResizeColumns(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	model := initialBoardModel(&Config{JiraURL: "https://test.atlassian.net", Projects: []string{"TEST"}})
+	model.width, model.height = 126, 30
+	model.loading = false
+	model.selectedCol = 0
+	before := model.columnWidths(model.allColumns())
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'+'}})
+	model = updated.(boardModel)
+	after := model.columnWidths(model.allColumns())
+	if after[0] <= before[0] || after[1] >= before[1] || after[2] >= before[2] {
+		t.Errorf("+ should widen the selected column at the others' expense: %v -> %v", before, after)
+	}
+
+	for i := 0; i < 20; i++ {
+		updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'-'}})
+		model = updated.(boardModel)
+	}
+	if got := model.columnWidths(model.allColumns())[0]; got < 16 {
+		t.Errorf("- should stop at the minimum width, got %d", got)
+	}
+	if model.statusMsg == "" {
+		t.Error("hitting the limit should say so")
+	}
+
+	widths := model.columnWidths(model.allColumns())
+	model.moveSelectedColumn(1)
+	if moved := model.columnWidths(model.allColumns()); moved[1] != widths[0] || moved[0] != widths[1] {
+		t.Errorf("moving a column should keep its width: %v -> %v", widths, moved)
+	}
+
+	model.saveUIPreferences()
+	if saved := usercfg.GetUIPrefs().ColumnWidths; len(saved) != 3 {
+		t.Errorf("adjusted widths should be saved, got %v", saved)
+	}
+}
+
+func TestBoardModel_AppliesSavedColumnWidths(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	if err := usercfg.UpdateUIPrefs(func(prefs *usercfg.UIPreferences) { prefs.ColumnWidths = []int{60, 20, 20} }); err != nil {
+		t.Fatal(err)
+	}
+	cfg := &Config{JiraURL: "https://test.atlassian.net", Projects: []string{"TEST"}}
+	model := initialBoardModel(cfg)
+
+	model.width = 106
+	if got := model.columnWidths(model.allColumns()); got[0] != 60 || got[1] != 20 || got[2] != 20 {
+		t.Errorf("saved widths should apply, got %v", got)
+	}
+	model.width = 206
+	if got := model.columnWidths(model.allColumns()); got[0] != 120 || got[1] != 40 || got[2] != 40 {
+		t.Errorf("saved widths should scale with the terminal, got %v", got)
+	}
+
+	// A fourth column (all_statuses) doesn't match the saved layout
+	cfg.AllStatuses = true
+	if model := initialBoardModel(cfg); model.columnWeights != nil {
+		t.Errorf("widths saved for 3 columns should not apply to 4, got %v", model.columnWeights)
+	}
+}
+
+func TestBoardModel_TerminalTooSmall(t *testing.T) {
+	model := initialBoardModel(&Config{JiraURL: "https://test.atlassian.net", Projects: []string{"TEST"}})
+	updated, _ := model.Update(tea.WindowSizeMsg{Width: 24, Height: 8})
+	view := updated.(boardModel).View()
+	if !strings.Contains(view, "Terminal too small") || strings.Contains(view, "Personal Kanban") {
+		t.Errorf("a tiny terminal should get the resize hint instead of the board:\n%s", view)
+	}
+
+	updated, _ = updated.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	if view := updated.(boardModel).View(); strings.Contains(view, "Terminal too small") {
+		t.Errorf("the board should render again after resizing:\n%s", view)
+	}
+}
+
+func TestIssueTypeMarkers(t *testing.T) {
+	t.Setenv("NO_COLOR", "")
+	t.Setenv("TERM", "xterm-256color")
+	markers := issueTypeMarkers(map[string]string{"Incident": "🔥", "Task": ""})
+	if markers["bug"] != "🐛" || markers["incident"] != "🔥" || markers["task"] != "" {
+		t.Errorf("overrides should merge over the defaults by lowercased type: %v", markers)
+	}
+
+	t.Setenv("NO_COLOR", "1")
+	if got := issueTypeMarkers(nil)["story"]; got != "[S]" {
+		t.Errorf("NO_COLOR should fall back to ASCII markers, got %q", got)
+	}
+
+	model := initialBoardModel(&Config{JiraURL: "https://test.atlassian.net", Projects: []string{"TEST"}, IssueTypeMarkers: markers})
+	model.width, model.height = 140, 30
+	var bug, task JiraIssue
+	bug.Key, bug.Fields.Summary, bug.Fields.IssueType.Name = "TEST-1", "Crash on save", "Bug"
+	task.Key, task.Fields.Summary, task.Fields.IssueType.Name = "TEST-2", "Write docs", "Task"
+	model.columns[0].issues = []JiraIssue{bug, task}
+	view := model.View()
+	if !strings.Contains(view, "🐛 TEST-1") || strings.Contains(view, "✔ TEST-2") {
+		t.Errorf("rows should carry their type marker, with Task's turned off:\n%s", view)
+	}
+}
+
+func TestBoardModel_RebuildCache(t *testing.T) {
+	model := initialBoardModel(&Config{JiraURL: "https://test.atlassian.net", Projects: []string{"TEST"}})
+	model.width, model.height = 140, 30
+	model.loading = false
+	model.columns[0].allByScope = map[scopeFilter][]JiraIssue{scopeMine: {{Key: "TEST-1"}}}
+	model.issueDetails = map[string]JiraIssue{"TEST-1": {Key: "TEST-1"}}
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'R'}})
+	model = updated.(boardModel)
+	if cmd == nil || !model.loading || model.columns[0].allByScope != nil || len(model.issueDetails) != 0 {
+		t.Fatal("R should drop cached scopes and details and reload")
+	}
+	if view := model.View(); !strings.Contains(view, "Rebuilding cache") {
+		t.Errorf("a rebuild should be labelled differently from a refresh:\n%s", view)
+	}
+
+	updated, _ = model.Update(dataLoadedMsg{columns: model.columns})
+	if updated.(boardModel).rebuilding {
+		t.Error("the rebuild label should clear once data loads")
+	}
+}
+
+func TestAssigneeBadges(t *testing.T) {
+	cases := map[string]string{"Jane Doe": "JD", "Jane van der Berg": "JB", "cher": "CH", "Ö": "Ö", "": "--"}
+	for name, want := range cases {
+		if got := assigneeInitials(name); got != want {
+			t.Errorf("assigneeInitials(%q) = %q, want %q", name, got, want)
+		}
+	}
+
+	model := initialBoardModel(&Config{JiraURL: "https://test.atlassian.net", Projects: []string{"TEST"}, AssigneeDisplay: "badges"})
+	model.width, model.height = 140, 30
+	var a, b JiraIssue
+	a.Key, a.Fields.Assignee.AccountID, a.Fields.Assignee.DisplayName = "TEST-1", "acc-1", "Jane Doe"
+	b.Key, b.Fields.Assignee.AccountID, b.Fields.Assignee.DisplayName = "TEST-2", "acc-1", "Jane D."
+	if model.badgeStyle(a).Render("x") != model.badgeStyle(b).Render("x") {
+		t.Error("the same account should always get the same badge color")
+	}
+
+	model.layout = rowNormal
+	model.columns[0].issues = []JiraIssue{a}
+	if view := model.View(); !strings.Contains(view, "JD") || strings.Contains(view, "@Jane") {
+		t.Errorf("badges mode should show initials instead of @firstname:\n%s", view)
+	}
+}
+
+func TestApplyStatusOverrides(t *testing.T) {
+	issue := func(key, status string) JiraIssue {
+		var it JiraIssue
+		it.Key, it.Fields.Status.Name = key, status
+		return it
+	}
+	byColumn := map[string][]JiraIssue{
+		"In Progress": {issue("TEST-1", "In Progress"), issue("TEST-2", "Ready for Review")},
+		"Done":        {issue("TEST-3", "Closed")},
+	}
+	got := applyStatusOverrides(byColumn, map[string]string{"ready for review": "done", "Triage": "To Do"})
+	if len(got["In Progress"]) != 1 || len(got["Done"]) != 2 || got["Done"][1].Key != "TEST-2" {
+		t.Errorf("Ready for Review should move after Done's own issues: %v", got)
+	}
+
+	// The target column wasn't fetched (e.g. a lazy scope load), so the issue stays
+	partial := map[string][]JiraIssue{"In Progress": {issue("TEST-2", "Ready for Review")}}
+	if got := applyStatusOverrides(partial, map[string]string{"Ready for Review": "Done"}); len(got["In Progress"]) != 1 {
+		t.Errorf("issues should not vanish when their target column isn't loaded: %v", got)
+	}
+}
+
+func TestLoadTimeout(t *testing.T) {
+	if got := loadTimeout(Config{}, defaultScopeLoadTimeout); got != defaultScopeLoadTimeout {
+		t.Errorf("unset timeout should keep the default, got %v", got)
+	}
+	cfg := Config{BoardLoadTimeout: 45 * time.Second}
+	if loadTimeout(cfg, defaultBoardLoadTimeout) != 45*time.Second || loadTimeout(cfg, defaultScopeLoadTimeout) != 45*time.Second {
+		t.Error("board_load_timeout_seconds should replace both defaults")
+	}
+}
+
+func TestBoardModel_NoResultsHint(t *testing.T) {
+	model := initialBoardModel(&Config{JiraURL: "https://test.atlassian.net", Projects: []string{"TEST"}})
+	model.width, model.height = 160, 30
+	model.loading = false
+	if view := model.View(); strings.Contains(view, "No issues for scope") {
+		t.Error("columns that haven't loaded shouldn't get the empty hint")
+	}
+
+	for i := range model.columns {
+		model.columns[i].allByScope = map[scopeFilter][]JiraIssue{model.curScope: nil}
+	}
+	if view := model.View(); !strings.Contains(view, "No issues for scope '"+scopeToString(model.curScope)+"'") {
+		t.Errorf("an empty board should explain itself:\n%s", view)
+	}
+	model.filter = "login"
+	if hint := model.noResultsHint([]int{0, 1, 2}); !strings.Contains(hint, `filter "login"`) || !strings.Contains(hint, "/ to adjust") {
+		t.Errorf("the hint should mention the filter: %s", hint)
+	}
+	model.filter = ""
+	model.blockedOnly, model.treeRoot = true, "TEST-9"
+	model.hidden["TEST-2"] = true
+	hint := model.noResultsHint([]int{0, 1, 2})
+	for _, want := range []string{"blocked only", "B to show all", "TEST-9 tree", "f to clear", "1 hidden", "X to show hidden"} {
+		if !strings.Contains(hint, want) {
+			t.Errorf("the hint should mention %q: %s", want, hint)
+		}
+	}
+	model.blockedOnly, model.treeRoot = false, ""
+	clear(model.hidden)
+	model.preset = "mine"
+	if hint := model.noResultsHint([]int{0, 1, 2}); !strings.Contains(hint, "preset 'mine'") || strings.Contains(hint, "s to change scope") {
+		t.Errorf("with a preset the hint shouldn't offer s: %s", hint)
+	}
+	model.preset = ""
+
+	model.columns[0].issues = []JiraIssue{{Key: "TEST-1"}}
+	if view := model.View(); strings.Contains(view, "No issues") {
+		t.Error("no hint while any column has issues")
+	}
+}
+
+func TestBoardModel_StartWorkOutcome(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	model := initialBoardModel(&Config{JiraURL: "https://test.atlassian.net", Projects: []string{"TEST"}})
+	model.loading = false
+
+	updated, cmd := model.Update(startWorkMsg{issue: JiraIssue{Key: "TEST-1"}, branch: "TEST-1_fix", err: errors.New("HTTP 400")})
+	got := updated.(boardModel)
+	if !strings.Contains(got.statusMsg, "On TEST-1_fix") || !strings.Contains(got.statusMsg, "transition failed") || got.loading {
+		t.Errorf("a failed transition should still report the branch, got %q", got.statusMsg)
+	}
+	if cmd == nil {
+		t.Error("the status should be cleared later")
+	}
+
+	updated, _ = model.Update(startWorkMsg{issue: JiraIssue{Key: "TEST-1"}, branch: "TEST-1_fix", skipped: true, reason: "TEST-1 is already In Progress"})
+	if got := updated.(boardModel); got.loading || !strings.Contains(got.statusMsg, "already In Progress") {
+		t.Errorf("an issue already there shouldn't reload, got %q", got.statusMsg)
+	}
+
+	updated, _ = model.Update(startWorkMsg{issue: JiraIssue{Key: "TEST-1"}, branch: "TEST-1_fix", reason: "TEST-1 moved to In Progress"})
+	if got := updated.(boardModel); !got.loading {
+		t.Error("a moved issue should reload the board")
+	}
+
+	updated, _ = model.Update(startWorkMsg{issue: JiraIssue{Key: "TEST-1"}, branch: "TEST-1_fix", notes: "Changes stashed.", reason: "TEST-1 moved to In Progress"})
+	if got := updated.(boardModel); !strings.Contains(got.statusMsg, "Changes stashed.") {
+		t.Errorf("the checkout's captured output should be reported, got %q", got.statusMsg)
+	}
+
+	updated, _ = model.Update(startWorkMsg{issue: JiraIssue{Key: "TEST-1"}, checkoutErr: errors.New("branch switch cancelled: uncommitted changes")})
+	if got := updated.(boardModel); got.loading || !strings.Contains(got.statusMsg, "Branch failed") {
+		t.Errorf("a failed checkout shouldn't transition or reload, got %q", got.statusMsg)
+	}
+
+	updated, _ = model.Update(startWorkMsg{issue: JiraIssue{Key: "TEST-1"}, checkoutErr: &branchCollisionError{branch: "TEST-1_fix", alt: "TEST-1_fix-2"}})
+	if got := updated.(boardModel); got.collision == nil || got.collision.action != "S" {
+		t.Error("a collision during S should open the board's prompt")
+	}
+
+	if got := startTransitionTarget(&Config{}); got != "In Progress" {
+		t.Errorf("S should default to In Progress, got %q", got)
+	}
+	if got := startTransitionTarget(&Config{OnStartTransition: "Doing"}); got != "Doing" {
+		t.Errorf("on_start_transition should set the S target, got %q", got)
+	}
+}
+
+func TestBoardModel_BranchCollisionPrompt(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	model := initialBoardModel(&Config{JiraURL: "https://test.atlassian.net", Projects: []string{"TEST"}})
+	model.loading = false
+	model.width, model.height = 120, 40
+	issue := JiraIssue{Key: "TEST-1"}
+
+	updated, cmd := model.checkoutFailed("b", issue, &branchCollisionError{branch: "TEST-1_fix", alt: "TEST-1_fix-2"})
+	got := updated.(boardModel)
+	if got.collision == nil || got.collision.alt != "TEST-1_fix-2" || cmd != nil || got.err != nil {
+		t.Fatalf("a collision should open the board's prompt, got %+v err %v", got.collision, got.err)
+	}
+	if view := got.View(); !strings.Contains(view, "c check it out") || !strings.Contains(view, "TEST-1_fix-2") {
+		t.Error("the prompt should offer both branches")
+	}
+
+	updated, _ = got.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if got := updated.(boardModel); got.collision != nil || !strings.Contains(got.statusMsg, "Left TEST-1_fix alone") {
+		t.Errorf("esc should close the prompt without checking anything out, got %q", got.statusMsg)
+	}
+
+	updated, _ = model.checkoutFailed("b", issue, errors.New("git checkout failed"))
+	if got := updated.(boardModel); got.collision != nil || got.err == nil {
+		t.Error("other checkout errors should be shown, not prompted")
+	}
+}
+
+func TestBoardModel_EnterWithoutClaude(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Chdir(t.TempDir())
+	for _, args := range [][]string{{"init", "-q"}, {"config", "user.email", "test@example.com"}, {"config", "user.name", "Test"}, {"commit", "-q", "--allow-empty", "-m", "init"}} {
+		if out, err := exec.Command("git", args...).CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	origLookPath := lookPath
+	defer func() { lookPath = origLookPath }()
+	lookPath = func(file string) (string, error) { return "", exec.ErrNotFound }
+
+	model := initialBoardModel(&Config{JiraURL: "https://test.atlassian.net", Projects: []string{"TEST"}, EnableClaude: true})
+	model.loading = false
+	issue := JiraIssue{Key: "TEST-1"}
+	issue.Fields.Summary = "Fix login"
+	model.columns[0].allIssues = []JiraIssue{issue}
+	model.columns[0].issues = []JiraIssue{issue}
+	model.selectedCol = 0
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	origStdout := os.Stdout
+	os.Stdout = w
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	w.Close()
+	os.Stdout = origStdout
+	out, _ := io.ReadAll(r)
+
+	got := updated.(boardModel)
+	if got.err != nil {
+		t.Fatalf("enter failed: %v", got.err)
+	}
+	if got.pendingClaude {
+		t.Error("without claude on PATH, enter shouldn't spawn it")
+	}
+	if got.pendingIssue.Key != "TEST-1" || cmd == nil {
+		t.Error("enter should still check out the branch and quit")
+	}
+	if !strings.Contains(string(out), "claude not found") || !strings.Contains(string(out), "TEST-1: Fix login") {
+		t.Errorf("the ticket context should be printed instead:\n%s", out)
+	}
+}
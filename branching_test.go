@@ -1,7 +1,14 @@
 package main
 
 import (
+	stderrors "errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
 	"testing"
+
+	"gci/internal/errors"
 )
 
 // TestCreateBranchName verifies the hardcoded kebab-case branch naming
@@ -85,7 +92,7 @@ func TestCreateBranchName(t *testing.T) {
 			}
 			issue.Fields.Summary = tt.summary
 
-			result := createBranchName(issue)
+			result := createBranchName(&Config{}, issue)
 
 			if result != tt.expected {
 				t.Errorf("createBranchName() = %v, want %v\nDescription: %s",
@@ -127,7 +134,7 @@ func TestCreateBranchName_Truncation(t *testing.T) {
 			}
 			issue.Fields.Summary = tt.summary
 
-			result := createBranchName(issue)
+			result := createBranchName(&Config{}, issue)
 
 			// Extract summary part (after KEY_)
 			summaryPart := result[len(tt.key)+1:]
@@ -139,3 +146,251 @@ func TestCreateBranchName_Truncation(t *testing.T) {
 		})
 	}
 }
+
+// TestShouldStashBeforeSwitch verifies each on_dirty_tree policy with the git status check stubbed
+func TestShouldStashBeforeSwitch(t *testing.T) {
+	origDirty := hasUncommittedChanges
+	origConfirm := confirmStash
+	defer func() {
+		hasUncommittedChanges = origDirty
+		confirmStash = origConfirm
+	}()
+
+	tests := []struct {
+		name        string
+		policy      string
+		dirty       bool
+		confirm     bool
+		expectStash bool
+		expectErr   bool
+		expectAsked bool
+		captured    bool
+	}{
+		{name: "clean tree never stashes", policy: "stash", dirty: false},
+		{name: "clean tree never prompts", policy: "prompt", dirty: false},
+		{name: "stash policy stashes without asking", policy: "stash", dirty: true, expectStash: true},
+		{name: "abort policy refuses", policy: "abort", dirty: true, expectErr: true},
+		{name: "ignore policy proceeds without stash", policy: "ignore", dirty: true},
+		{name: "prompt accepted", policy: "prompt", dirty: true, confirm: true, expectStash: true, expectAsked: true},
+		{name: "prompt declined", policy: "prompt", dirty: true, confirm: false, expectErr: true, expectAsked: true},
+		{name: "empty policy defaults to prompt", policy: "", dirty: true, confirm: true, expectStash: true, expectAsked: true},
+		{name: "captured output can't prompt", policy: "prompt", dirty: true, confirm: true, expectErr: true, captured: true},
+		{name: "captured output still stashes", policy: "stash", dirty: true, expectStash: true, captured: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			asked := false
+			hasUncommittedChanges = func() bool { return tt.dirty }
+			confirmStash = func() (bool, error) {
+				asked = true
+				return tt.confirm, nil
+			}
+
+			var out io.Writer
+			if tt.captured {
+				out = &strings.Builder{}
+			}
+			stash, err := shouldStashBeforeSwitch(tt.policy, out)
+			if stash != tt.expectStash {
+				t.Errorf("stash = %v, want %v", stash, tt.expectStash)
+			}
+			if (err != nil) != tt.expectErr {
+				t.Errorf("err = %v, want error: %v", err, tt.expectErr)
+			}
+			if asked != tt.expectAsked {
+				t.Errorf("prompted = %v, want %v", asked, tt.expectAsked)
+			}
+		})
+	}
+}
+
+func TestCheckDirtyBeforeWorktree(t *testing.T) {
+	origDirty := hasUncommittedChanges
+	defer func() { hasUncommittedChanges = origDirty }()
+
+	hasUncommittedChanges = func() bool { return false }
+	if err := checkDirtyBeforeWorktree("abort", "/repo"); err != nil {
+		t.Errorf("a clean tree should never block a worktree: %v", err)
+	}
+
+	hasUncommittedChanges = func() bool { return true }
+	for _, policy := range []string{"prompt", "stash", "ignore", ""} {
+		if err := checkDirtyBeforeWorktree(policy, "/repo"); err != nil {
+			t.Errorf("policy %q should only warn, got %v", policy, err)
+		}
+	}
+	if err := checkDirtyBeforeWorktree("abort", "/repo"); err == nil {
+		t.Error("abort should refuse to create the worktree")
+	}
+}
+
+func TestNextFreeBranchName(t *testing.T) {
+	taken := map[string]bool{"INF-42_fix": true, "INF-42_fix-2": true}
+	exists := func(name string) bool { return taken[name] }
+	if got := nextFreeBranchName("INF-42_fix", exists); got != "INF-42_fix-3" {
+		t.Errorf("nextFreeBranchName = %q, want INF-42_fix-3", got)
+	}
+	if got := nextFreeBranchName("INF-7_other", exists); got != "INF-7_other-2" {
+		t.Errorf("nextFreeBranchName = %q, want INF-7_other-2", got)
+	}
+}
+
+func TestIssueKeyFromBranch(t *testing.T) {
+	tests := []struct {
+		branch string
+		want   string
+	}{
+		{"INF-42_fix-login", "INF-42"},
+		{"INF-42", "INF-42"},
+		{"AB2-7_two-part-key", "AB2-7"},
+		{"feature/INF-42_nested", ""},
+		{"inf-42_lowercase", ""},
+		{"main", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := issueKeyFromBranch(&Config{}, tt.branch); got != tt.want {
+			t.Errorf("issueKeyFromBranch(%q) = %q, want %q", tt.branch, got, tt.want)
+		}
+	}
+}
+
+func TestBranchAlreadyMatches(t *testing.T) {
+	tests := []struct {
+		branch string
+		want   bool
+	}{
+		{"INF-42_fix-login-redirect", true},
+		{"INF-42_Fix_Login_Redirect", true},
+		{"INF-42-fix-login-redirect", true},
+		{"INF-42_fix-login", false},
+		{"INF-43_fix-login-redirect", false},
+		{"fix-login-redirect", false},
+		{"main", false},
+	}
+	for _, tt := range tests {
+		if got := branchAlreadyMatches(&Config{}, tt.branch, "INF-42", "Fix login redirect"); got != tt.want {
+			t.Errorf("branchAlreadyMatches(%q) = %v, want %v", tt.branch, got, tt.want)
+		}
+	}
+}
+
+// TestBranchKeyOptions covers every branch_strip_project / branch_key_case combination
+func TestBranchKeyOptions(t *testing.T) {
+	tests := []struct {
+		strip   bool
+		keyCase string
+		want    string
+		key     string // issueKeyFromBranch(want)
+	}{
+		{false, "", "INF-42_fix-login", "INF-42"},
+		{false, "upper", "INF-42_fix-login", "INF-42"},
+		{false, "lower", "inf-42_fix-login", "INF-42"},
+		{true, "", "42_fix-login", ""},
+		{true, "upper", "42_fix-login", ""},
+		{true, "lower", "42_fix-login", ""},
+	}
+	for _, tt := range tests {
+		config := &Config{BranchStripProject: tt.strip, BranchKeyCase: tt.keyCase}
+		got := makeBranchName(config, "INF-42", "Fix login")
+		if got != tt.want {
+			t.Errorf("strip=%v case=%q: makeBranchName = %q, want %q", tt.strip, tt.keyCase, got, tt.want)
+		}
+		if key := issueKeyFromBranch(config, got); key != tt.key {
+			t.Errorf("strip=%v case=%q: issueKeyFromBranch(%q) = %q, want %q", tt.strip, tt.keyCase, got, key, tt.key)
+		}
+		if !branchAlreadyMatches(config, got, "INF-42", "Fix login") || branchAlreadyMatches(config, got, "INF-4", "Fix login") {
+			t.Errorf("strip=%v case=%q: branchAlreadyMatches should recognize %q only for INF-42", tt.strip, tt.keyCase, got)
+		}
+	}
+}
+
+func TestFitDiff(t *testing.T) {
+	fileDiff := func(name string, hunks ...string) string {
+		d := "diff --git a/" + name + " b/" + name + "\n--- a/" + name + "\n+++ b/" + name + "\n"
+		for i, h := range hunks {
+			d += fmt.Sprintf("@@ -%d,1 +%d,1 @@\n%s\n", i+1, i+1, h)
+		}
+		return d
+	}
+	big := strings.Repeat("+long line of changes\n", 20)
+	diff := fileDiff("main.go", "+small change") +
+		fileDiff("package-lock.json", "+\"lodash\": \"4.17.21\"") +
+		"diff --git a/logo.png b/logo.png\nindex 1..2 100644\nBinary files a/logo.png and b/logo.png differ\n" +
+		fileDiff("big.go", "+first", big, big) +
+		fileDiff("huge.go", big+big+big)
+	stat := " main.go | 1 +\n 5 files changed\n"
+
+	got := fitDiff(stat, diff, 700)
+	if !strings.HasPrefix(got, stat) || !strings.Contains(got, "+small change") {
+		t.Errorf("stat and small files should be kept:\n%s", got)
+	}
+	if strings.Contains(got, "lodash") || strings.Contains(got, "Binary files") {
+		t.Errorf("lockfile and binary diffs should be dropped:\n%s", got)
+	}
+	if !strings.Contains(got, "+first") || strings.Count(got, "long line of changes") != 20 {
+		t.Errorf("big.go should keep only its hunks that fit:\n%s", got)
+	}
+	for _, note := range []string{"package-lock.json (lockfile)", "logo.png (binary)", "big.go (2 of 3 hunks)", "huge.go (too large)"} {
+		if !strings.Contains(got, note) {
+			t.Errorf("missing omission note %q:\n%s", note, got)
+		}
+	}
+	if len(got) > 700+200 {
+		t.Errorf("diff should stay near the limit, got %d characters", len(got))
+	}
+
+	if got := fitDiff("", fileDiff("a.go", "+x"), 8000); got != fileDiff("a.go", "+x") {
+		t.Errorf("a diff under the limit should pass through unchanged:\n%s", got)
+	}
+}
+
+func TestPickAndBranch_KeepOpenReturnsToPicker(t *testing.T) {
+	t.Chdir(t.TempDir()) // not a git repo, so every branch attempt fails
+	issues := []JiraIssue{{Key: "TEST-1"}, {Key: "TEST-2"}}
+	origSelect := selectIssue
+	defer func() { selectIssue = origSelect }()
+	picks := 0
+	selectIssue = func(got []JiraIssue) (JiraIssue, error) {
+		if len(got) != len(issues) {
+			t.Errorf("picker should reuse the fetched issues, got %d", len(got))
+		}
+		picks++
+		if picks > 2 {
+			return JiraIssue{}, fmt.Errorf("interrupt")
+		}
+		return got[picks-1], nil
+	}
+
+	if err := pickAndBranch(&Config{}, issues); err == nil || picks != 1 {
+		t.Errorf("without keep_open a failed branch should end the run, picks %d err %v", picks, err)
+	}
+
+	picks = 0
+	if err := pickAndBranch(&Config{KeepOpen: true}, issues); err != nil || picks != 3 {
+		t.Errorf("keep_open should return to the picker until quit, picks %d err %v", picks, err)
+	}
+}
+
+func TestCheckoutIssueBranch_CollisionWithoutTerminal(t *testing.T) {
+	t.Chdir(t.TempDir())
+	for _, args := range [][]string{{"init", "-q"}, {"config", "user.email", "test@example.com"}, {"config", "user.name", "Test"}, {"commit", "-q", "--allow-empty", "-m", "init"}, {"branch", "INF-42_fix"}} {
+		if out, err := exec.Command("git", args...).CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	origTTY, origRelated := stdinIsTerminal, branchLooksRelated
+	defer func() { stdinIsTerminal, branchLooksRelated = origTTY, origRelated }()
+	stdinIsTerminal = func() bool { return false }
+	branchLooksRelated = func(branch, key string) bool { return false }
+
+	_, err := checkoutIssueBranch("INF-42_fix", "INF-42", dirtyTreeIgnore)
+	var userErr *errors.UserError
+	if !stderrors.As(err, &userErr) || !strings.Contains(userErr.Remediation, "INF-42_fix-2") {
+		t.Fatalf("expected an error naming INF-42_fix-2, got %v", err)
+	}
+	if got := getCurrentBranch(); got == "INF-42_fix" {
+		t.Error("an unrelated branch must not be checked out without a terminal")
+	}
+}
@@ -0,0 +1,482 @@
+package main
+
+import (
+	"bytes"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"gci/internal/errors"
+	"gci/internal/usercfg"
+
+	"github.com/AlecAivazis/survey/v2/terminal"
+)
+
+func TestTokenPathForProjects(t *testing.T) {
+	userConfig := usercfg.Config{
+		OPJiraTokenPath: "op://Private/JIRA/credential",
+		ProjectTokenPaths: map[string]string{
+			"INF":   "op://Infra/JIRA/credential",
+			"OPS":   "op://Infra/JIRA/credential",
+			"OTHER": "op://Other/JIRA/credential",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		projects []string
+		expected string
+	}{
+		{"single listed project", []string{"INF"}, "op://Infra/JIRA/credential"},
+		{"projects sharing a path", []string{"INF", "OPS"}, "op://Infra/JIRA/credential"},
+		{"projects with different paths", []string{"INF", "OTHER"}, "op://Private/JIRA/credential"},
+		{"unlisted project", []string{"APP"}, "op://Private/JIRA/credential"},
+		{"mix of listed and unlisted", []string{"INF", "APP"}, "op://Private/JIRA/credential"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tokenPathForProjects(userConfig, tt.projects); got != tt.expected {
+				t.Errorf("tokenPathForProjects(%v) = %q, want %q", tt.projects, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTokenPathForProjects_MixedPathsWithoutDefault(t *testing.T) {
+	userConfig := usercfg.Config{
+		ProjectTokenPaths: map[string]string{
+			"INF":   "op://Infra/JIRA/credential",
+			"OTHER": "op://Other/JIRA/credential",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		projects []string
+		expected string
+	}{
+		{"projects with different paths", []string{"INF", "OTHER"}, "op://Infra/JIRA/credential"},
+		{"order decides", []string{"OTHER", "INF"}, "op://Other/JIRA/credential"},
+		{"unlisted project first", []string{"APP", "OTHER"}, "op://Other/JIRA/credential"},
+		{"no listed project", []string{"APP"}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tokenPathForProjects(userConfig, tt.projects); got != tt.expected {
+				t.Errorf("tokenPathForProjects(%v) = %q, want %q", tt.projects, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPickerStatusPredicate(t *testing.T) {
+	cfg := &Config{}
+	if got := pickerStatusPredicate(cfg); got != `statusCategory != "Done"` {
+		t.Errorf("Default predicate = %q", got)
+	}
+
+	cfg.StatusCategoryNames = map[string]string{"done": "Fertig"}
+	if got := pickerStatusPredicate(cfg); got != `statusCategory != "Fertig"` {
+		t.Errorf("Localized predicate = %q", got)
+	}
+
+	cfg.PickerStatuses = []string{"Open", "Change Approved", `Say "hi"`}
+	want := `status in ("Open", "Change Approved", "Say \"hi\"")`
+	if got := pickerStatusPredicate(cfg); got != want {
+		t.Errorf("Configured predicate = %q, want %q", got, want)
+	}
+
+	cfg.IncludeDone = true
+	if got := pickerStatusPredicate(cfg); got != "("+want+" OR resolved >= -30d)" {
+		t.Errorf("--include-done predicate = %q", got)
+	}
+	cfg.PickerStatuses = nil
+	if got := pickerStatusPredicate(cfg); got != `(statusCategory != "Fertig" OR resolved >= -30d)` {
+		t.Errorf("--include-done default predicate = %q", got)
+	}
+}
+
+func TestPickerStatuses_FlagOverridesConfig(t *testing.T) {
+	defer func() { statusFlags = nil }()
+	userConfig := usercfg.Config{PickerStatuses: []string{"Open"}}
+	if got := pickerStatuses(userConfig); len(got) != 1 || got[0] != "Open" {
+		t.Errorf("picker_statuses should apply without --status, got %v", got)
+	}
+	statusFlags = []string{"Resolved", "Closed"}
+	if got := pickerStatuses(userConfig); len(got) != 2 || got[0] != "Resolved" {
+		t.Errorf("--status should replace picker_statuses, got %v", got)
+	}
+}
+
+func TestCheckJiraToken(t *testing.T) {
+	tests := []struct {
+		status int
+		want   tokenCheckResult
+	}{
+		{http.StatusOK, tokenValid},
+		{http.StatusUnauthorized, tokenRejected},
+		{http.StatusForbidden, tokenUnverified},
+	}
+	for _, tt := range tests {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(tt.status)
+		}))
+		if got := checkJiraToken(server.URL, "test@example.com", "token"); got != tt.want {
+			t.Errorf("HTTP %d: got %v, want %v", tt.status, got, tt.want)
+		}
+		server.Close()
+	}
+
+	// Unreachable hosts are not treated as a bad token
+	if got := checkJiraToken("http://127.0.0.1:1", "test@example.com", "token"); got != tokenUnverified {
+		t.Errorf("Unreachable host: got %v, want tokenUnverified", got)
+	}
+}
+
+func TestResolveEmail_Overrides(t *testing.T) {
+	defer func(prev string) { emailFlag = prev }(emailFlag)
+
+	userConfig := usercfg.Config{
+		JiraEmail:      "me@jira.example.com",
+		EmailDomainMap: map[string]string{"git.example.com": "jira.example.com"},
+	}
+
+	emailFlag = ""
+	if got, err := resolveEmail(userConfig, ""); err != nil || got != "me@jira.example.com" {
+		t.Errorf("jira_email: got %q, %v", got, err)
+	}
+
+	emailFlag = "flag@example.com"
+	if got, err := resolveEmail(userConfig, ""); err != nil || got != "flag@example.com" {
+		t.Errorf("--email should win over jira_email: got %q, %v", got, err)
+	}
+
+	emailFlag = "not-an-email"
+	if _, err := resolveEmail(userConfig, ""); err == nil {
+		t.Error("expected an error for an invalid --email")
+	}
+
+	emailFlag = ""
+	userConfig.JiraEmail = "Me <me@example.com>"
+	if _, err := resolveEmail(userConfig, ""); err == nil {
+		t.Error("expected an error for a jira_email that is not a bare address")
+	}
+}
+
+func TestOnePasswordUsernamePath(t *testing.T) {
+	cases := map[string]string{
+		"op://Private/JIRA/credential":         "op://Private/JIRA/username",
+		"op://Private/JIRA/section/credential": "op://Private/JIRA/section/username",
+		"op://Private/JIRA":                    "",
+		"":                                     "",
+		"/tmp/token":                           "",
+	}
+	for in, want := range cases {
+		if got := onePasswordUsernamePath(in); got != want {
+			t.Errorf("onePasswordUsernamePath(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestNotef_Quiet(t *testing.T) {
+	capture := func(q bool) string {
+		orig, origQuiet := os.Stdout, quiet
+		r, w, err := os.Pipe()
+		if err != nil {
+			t.Fatal(err)
+		}
+		os.Stdout, quiet = w, q
+		notef("Found %d issue(s).\n", 3)
+		w.Close()
+		os.Stdout, quiet = orig, origQuiet
+		out, _ := io.ReadAll(r)
+		return string(out)
+	}
+
+	if got := capture(false); got != "Found 3 issue(s).\n" {
+		t.Errorf("notef without --quiet printed %q", got)
+	}
+	if got := capture(true); got != "" {
+		t.Errorf("notef with --quiet printed %q, want nothing", got)
+	}
+	if f := rootCmd.PersistentFlags().ShorthandLookup("q"); f == nil || f.Name != "quiet" {
+		t.Errorf("-q should be the --quiet shorthand, got %v", f)
+	}
+}
+
+func TestReadOnePasswordSecret_UsesSigninSession(t *testing.T) {
+	dir := t.TempDir()
+	script := "#!/bin/sh\n" +
+		"if [ \"$3\" = \"--session\" ] && [ \"$4\" = \"tok\" ]; then echo secret; exit 0; fi\n" +
+		"echo '[ERROR] You are not currently signed in.' >&2\nexit 1\n"
+	if err := os.WriteFile(filepath.Join(dir, "op"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", dir)
+	defer func() { opSession = "" }()
+
+	if _, err := readOnePasswordSecret("op://Private/JIRA/credential"); !stderrors.Is(err, errors.ErrOnePasswordNotSignedIn) {
+		t.Fatalf("expected a not-signed-in error, got %v", err)
+	}
+	// Without a terminal there is nobody to ask, so no signin is attempted
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	defer w.Close()
+	origStdin := os.Stdin
+	os.Stdin = r
+	defer func() { os.Stdin = origStdin }()
+	if offerOnePasswordSignin() {
+		t.Error("offerOnePasswordSignin should decline when stdin is not a terminal")
+	}
+
+	opSession = "tok"
+	if token, err := readOnePasswordSecret("op://Private/JIRA/credential"); err != nil || token != "secret" {
+		t.Errorf("read with the signin session = %q, %v", token, err)
+	}
+}
+
+func TestScopeForProjects(t *testing.T) {
+	userConfig := usercfg.Config{
+		DefaultScope:  "assigned_or_reported",
+		ProjectScopes: map[string]string{"INF": "assigned", "BUGS": "reported"},
+	}
+	cases := []struct {
+		projects []string
+		want     string
+		override bool
+	}{
+		{[]string{"INF"}, "assigned", true},
+		{[]string{"BUGS"}, "reported", true},
+		{[]string{"OPS"}, "assigned_or_reported", false},
+		{[]string{"INF", "BUGS"}, "assigned_or_reported", false},
+	}
+	for _, tc := range cases {
+		if got, override := scopeForProjects(userConfig, tc.projects); got != tc.want || override != tc.override {
+			t.Errorf("scopeForProjects(%v) = %q, %v; want %q, %v", tc.projects, got, override, tc.want, tc.override)
+		}
+	}
+
+	// A project scope beats the scope the board was last left on
+	t.Setenv("HOME", t.TempDir())
+	if err := usercfg.UpdateUIPrefs(func(prefs *usercfg.UIPreferences) { prefs.LastScope = "unassigned" }); err != nil {
+		t.Fatal(err)
+	}
+	cfg := &Config{JiraURL: "https://test.atlassian.net", Projects: []string{"BUGS"}, DefaultScope: "reported", ProjectScope: true}
+	if got := initialBoardModel(cfg).curScope; got != scopeReported {
+		t.Errorf("board scope = %v, want reported from project_scopes", got)
+	}
+	cfg.ProjectScope = false
+	if got := initialBoardModel(cfg).curScope; got != scopeUnassigned {
+		t.Errorf("board scope = %v, want the saved last scope", got)
+	}
+}
+
+func TestWithDefaultProject(t *testing.T) {
+	userConfig := usercfg.Config{Projects: []string{"INF", "BUGS"}, DefaultProject: "BUGS"}
+	if got, err := withDefaultProject(userConfig, ""); err != nil || got != "BUGS" {
+		t.Errorf("withDefaultProject() = %q, %v; want default_project BUGS", got, err)
+	}
+	if got, err := withDefaultProject(userConfig, "both"); err != nil || got != "both" {
+		t.Errorf("--project both = %q, %v; want both", got, err)
+	}
+	if _, err := withDefaultProject(usercfg.Config{Projects: []string{"INF"}, DefaultProject: "OPS"}, ""); err == nil {
+		t.Error("a default_project that isn't configured should be rejected")
+	}
+
+	// Commands other than the picker and jql see every project
+	if got, err := selectProjects(userConfig, ""); err != nil || len(got) != 2 {
+		t.Errorf("selectProjects() = %v, %v; want every configured project", got, err)
+	}
+}
+
+func TestIsOnePasswordPath(t *testing.T) {
+	cases := map[string]bool{
+		"op://Private/JIRA API Key/credential":         true,
+		"op://Private/JIRA/section/credential":         true,
+		"op://Private/JIRA":                            false,
+		"op://Private//credential":                     false,
+		"op://a/b/c/d/e":                               false,
+		"Private/JIRA/credential":                      false,
+		"https://my.1password.com/Private/JIRA/secret": false,
+	}
+	for path, want := range cases {
+		if got := isOnePasswordPath(path); got != want {
+			t.Errorf("isOnePasswordPath(%q) = %v, want %v", path, got, want)
+		}
+	}
+}
+
+func TestResolveTargetProject_UsesGCIProjects(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("GCI_PROJECTS", "OPS, BUGS")
+	defer func() { createProjectFlag = "" }()
+
+	// Create offers every runtime project, not just those in the loaded config
+	config := &Config{Projects: []string{"INF"}, DefaultProject: "BUGS"}
+	if got, err := resolveTargetProject(config); err != nil || got != "BUGS" {
+		t.Errorf("resolveTargetProject() = %q, %v; want the default project BUGS", got, err)
+	}
+
+	createProjectFlag = "OPS"
+	if got, err := resolveTargetProject(config); err != nil || got != "OPS" {
+		t.Errorf("--project OPS = %q, %v; want OPS from GCI_PROJECTS", got, err)
+	}
+
+	createProjectFlag = "INF"
+	_, err := resolveTargetProject(config)
+	var userErr *errors.UserError
+	if !stderrors.As(err, &userErr) {
+		t.Errorf("--project INF should be rejected when GCI_PROJECTS doesn't list it, got %v", err)
+	}
+
+	createProjectFlag = ""
+	t.Setenv("GCI_PROJECTS", "OPS")
+	if got, err := resolveTargetProject(config); err != nil || got != "OPS" {
+		t.Errorf("single runtime project = %q, %v; want OPS", got, err)
+	}
+}
+
+func TestLoadConfig_BoardFlagsOverrideConfig(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("JIRA_API_TOKEN", "test-token")
+	enabled := true
+	if err := usercfg.Save(usercfg.Config{
+		JiraURL:         "https://test.atlassian.net",
+		Projects:        []string{"TEST"},
+		JiraEmail:       "me@example.com",
+		EnableClaude:    &enabled,
+		EnableWorktrees: &enabled,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	defer func() { boardNoClaude, boardNoWorktree = false, false }()
+
+	config, err := loadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !config.EnableClaude || !config.EnableWorktrees {
+		t.Fatal("config should enable Claude and worktrees without flags")
+	}
+
+	boardNoClaude, boardNoWorktree = true, true
+	if config, err = loadConfig(); err != nil {
+		t.Fatal(err)
+	}
+	if config.EnableClaude || config.EnableWorktrees {
+		t.Error("--no-claude and --no-worktree should win over the config")
+	}
+}
+
+func TestRequestTimeout(t *testing.T) {
+	defer func() { timeoutFlag = 0 }()
+	t.Setenv("GCI_TIMEOUT", "")
+	if got := requestTimeout(); got != 0 {
+		t.Errorf("no flag or env should keep per-request timeouts, got %v", got)
+	}
+	t.Setenv("GCI_TIMEOUT", "45")
+	if got := requestTimeout(); got != 45*time.Second {
+		t.Errorf("a bare GCI_TIMEOUT is seconds, got %v", got)
+	}
+	t.Setenv("GCI_TIMEOUT", "2m")
+	if got := requestTimeout(); got != 2*time.Minute {
+		t.Errorf("GCI_TIMEOUT should accept durations, got %v", got)
+	}
+	timeoutFlag = time.Minute
+	if got := requestTimeout(); got != time.Minute {
+		t.Errorf("--timeout should win over GCI_TIMEOUT, got %v", got)
+	}
+}
+
+func TestCreatePromptsWithoutTerminal(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("GCI_PROJECTS", "OPS,BUGS")
+	origTTY := stdinIsTerminal
+	defer func() {
+		stdinIsTerminal = origTTY
+		createTitle, createDescription = "", ""
+	}()
+	stdinIsTerminal = func() bool { return false }
+
+	var userErr *errors.UserError
+	if _, err := resolveTargetProject(&Config{}); !stderrors.As(err, &userErr) {
+		t.Errorf("several projects without a terminal should ask for --project, got %v", err)
+	}
+	if _, err := manualTicketEntry(); !stderrors.As(err, &userErr) {
+		t.Errorf("manual entry without a terminal should ask for --title, got %v", err)
+	}
+
+	if _, _, err := confirmTicketDetails(ticketSuggestion{Title: "Suggested", Description: "By Claude"}); !stderrors.As(err, &userErr) {
+		t.Errorf("a suggestion must not be accepted without a terminal or --title, got %v", err)
+	}
+	var issue JiraIssue
+	issue.Key = "OPS-7"
+	if createAnyway, err := offerExistingIssue(&Config{}, issue); createAnyway || !stderrors.As(err, &userErr) {
+		t.Errorf("a keyed branch without a terminal or --title should fail, got %v, %v", createAnyway, err)
+	}
+
+	createTitle = "Fix login redirect"
+	if createAnyway, err := offerExistingIssue(&Config{}, issue); !createAnyway || err != nil {
+		t.Errorf("--title without a terminal should create a new ticket, got %v, %v", createAnyway, err)
+	}
+	s, err := manualTicketEntry()
+	if err != nil || s.Title != createTitle || s.Description != createTitle {
+		t.Errorf("--title alone should fill title and description, got %+v, %v", s, err)
+	}
+	createDescription = "Send users back where they came from"
+	title, description, err := confirmTicketDetails(ticketFromFlags())
+	if err != nil || title != createTitle || description != createDescription {
+		t.Errorf("--title tickets should be used as-is, got %q %q %v", title, description, err)
+	}
+}
+
+func TestIsPromptCancel(t *testing.T) {
+	if !isPromptCancel(terminal.InterruptErr) || !isPromptCancel(fmt.Errorf("wrapped: %w", terminal.InterruptErr)) {
+		t.Error("Ctrl+C in a prompt should count as a cancellation")
+	}
+	if isPromptCancel(stderrors.New("HTTP 500")) || isPromptCancel(nil) {
+		t.Error("other errors must not be treated as a cancellation")
+	}
+}
+
+func TestPrintPaths(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("GCI_TIMEOUT", "45")
+	t.Setenv("JIRA_API_TOKEN", "secret-token")
+	if err := os.MkdirAll(filepath.Dir(usercfg.Path()), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(usercfg.Path(), []byte("projects = [\"INF\"]\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	printPaths(&buf)
+	out := buf.String()
+
+	for _, want := range []string{
+		usercfg.Path() + " (19 B)",
+		usercfg.LegacyPath() + " (missing)",
+		"Env overrides:  GCI_TIMEOUT, JIRA_API_TOKEN",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "secret-token") {
+		t.Errorf("env values must not be printed:\n%s", out)
+	}
+}
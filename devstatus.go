@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"gci/internal/httputil"
+	"gci/internal/logger"
+
+	"github.com/pkg/browser"
+)
+
+// openPullRequestURL opens a pull request in the browser; tests replace it
+var openPullRequestURL = browser.OpenURL
+
+// pullRequest is a pull request linked to an issue through JIRA's development panel
+type pullRequest struct {
+	ID     string `json:"id"` // e.g. "#42"
+	Name   string `json:"name"`
+	URL    string `json:"url"`
+	Status string `json:"status"` // OPEN, MERGED, DECLINED
+}
+
+// devStatusGet decodes a dev-status endpoint response. The dev-status API is internal
+// to JIRA Cloud and undocumented, so callers treat any error as "no data".
+func devStatusGet(ctx context.Context, config *Config, path string, params map[string]string, out interface{}) error {
+	client := httputil.NewDefaultClient()
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/rest/dev-status/latest/issue/%s", config.JiraURL, path), nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(config.Email, config.APIToken)
+	req.Header.Set("Accept", "application/json")
+	q := req.URL.Query()
+	for k, v := range params {
+		q.Add(k, v)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	logger.HTTP("GET", req.URL.String())
+	return client.DoJSONRequest(ctx, req, out)
+}
+
+// fetchLinkedPullRequests returns the pull requests linked to an issue (by numeric id)
+// across every connected code host, open ones first. The summary call finds which
+// hosts have pull requests; each host's details are then fetched.
+func fetchLinkedPullRequests(config *Config, issueID string) ([]pullRequest, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), httputil.Timeout(httputil.DefaultTimeout))
+	defer cancel()
+
+	var summary struct {
+		Summary struct {
+			PullRequest struct {
+				ByInstanceType map[string]struct {
+					Count int    `json:"count"`
+					Name  string `json:"name"`
+				} `json:"byInstanceType"`
+			} `json:"pullrequest"`
+		} `json:"summary"`
+	}
+	if err := devStatusGet(ctx, config, "summary", map[string]string{"issueId": issueID}, &summary); err != nil {
+		return nil, fmt.Errorf("development panel unavailable: %w", err)
+	}
+
+	var instanceTypes []string
+	for instanceType, s := range summary.Summary.PullRequest.ByInstanceType {
+		if s.Count > 0 {
+			instanceTypes = append(instanceTypes, instanceType)
+		}
+	}
+	sort.Strings(instanceTypes)
+
+	var prs []pullRequest
+	for _, instanceType := range instanceTypes {
+		var detail struct {
+			Detail []struct {
+				PullRequests []pullRequest `json:"pullRequests"`
+			} `json:"detail"`
+		}
+		params := map[string]string{"issueId": issueID, "applicationType": instanceType, "dataType": "pullrequest"}
+		if err := devStatusGet(ctx, config, "detail", params, &detail); err != nil {
+			logger.JIRA("dev-status detail for %s failed: %v", instanceType, err)
+			continue
+		}
+		for _, d := range detail.Detail {
+			prs = append(prs, d.PullRequests...)
+		}
+	}
+	sort.SliceStable(prs, func(i, j int) bool {
+		return strings.EqualFold(prs[i].Status, "OPEN") && !strings.EqualFold(prs[j].Status, "OPEN")
+	})
+	return prs, nil
+}
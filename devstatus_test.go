@@ -0,0 +1,131 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func devStatusServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("issueId") != "10001" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.URL.Path {
+		case "/rest/dev-status/latest/issue/summary":
+			w.Write([]byte(`{"summary":{"pullrequest":{"overall":{"count":2},"byInstanceType":{"GitHub":{"count":2,"name":"GitHub"},"bitbucket":{"count":0}}}}}`))
+		case "/rest/dev-status/latest/issue/detail":
+			if r.URL.Query().Get("applicationType") != "GitHub" || r.URL.Query().Get("dataType") != "pullrequest" {
+				t.Errorf("unexpected detail query: %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"detail":[{"pullRequests":[
+				{"id":"#41","name":"Old attempt","url":"https://github.com/o/r/pull/41","status":"DECLINED"},
+				{"id":"#42","name":"Fix login","url":"https://github.com/o/r/pull/42","status":"OPEN"}]}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestFetchLinkedPullRequests_IntegrationWithMockServer(t *testing.T) {
+	server := devStatusServer(t)
+	defer server.Close()
+	config := &Config{JiraURL: server.URL, Email: "test@example.com", APIToken: "test-token"}
+
+	prs, err := fetchLinkedPullRequests(config, "10001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(prs) != 2 || prs[0].ID != "#42" {
+		t.Errorf("expected both PRs with the open one first, got %+v", prs)
+	}
+
+	if _, err := fetchLinkedPullRequests(config, "99999"); err == nil {
+		t.Error("a missing development panel should be reported as an error")
+	}
+}
+
+func TestBoardModel_PullRequestPicker(t *testing.T) {
+	server := devStatusServer(t)
+	defer server.Close()
+	var opened []string
+	origOpen := openPullRequestURL
+	defer func() { openPullRequestURL = origOpen }()
+	openPullRequestURL = func(url string) error {
+		opened = append(opened, url)
+		return nil
+	}
+
+	model := initialBoardModel(&Config{JiraURL: server.URL, Projects: []string{"TEST"}})
+	model.width, model.height = 120, 30
+	issue := JiraIssue{ID: "10001", Key: "TEST-1"}
+	model.columns[model.selectedCol].issues = []JiraIssue{issue}
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'P'}})
+	if cmd == nil {
+		t.Fatal("P should look up pull requests")
+	}
+	updated, _ = updated.(boardModel).Update(cmd())
+	model = updated.(boardModel)
+	if model.prKey != "TEST-1" || len(model.prList) != 2 {
+		t.Fatalf("several PRs should be listed, got key %q list %v", model.prKey, model.prList)
+	}
+	if view := model.View(); !strings.Contains(view, "Fix login") {
+		t.Errorf("overlay should list the PRs:\n%s", view)
+	}
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'2'}})
+	model = updated.(boardModel)
+	if len(opened) != 1 || opened[0] != "https://github.com/o/r/pull/41" || model.prKey != "" {
+		t.Errorf("2 should open the second PR and close the list, opened %v", opened)
+	}
+
+	updated, _ = model.Update(pullRequestsMsg{key: "TEST-1", prs: []pullRequest{{ID: "#7", URL: "https://github.com/o/r/pull/7"}}})
+	if len(opened) != 2 || updated.(boardModel).prKey != "" {
+		t.Errorf("a single PR should open directly, opened %v", opened)
+	}
+}
+
+func TestBoardModel_RawIssueJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/issue/TEST-1" || r.URL.Query().Get("fields") != "*all" {
+			t.Errorf("unexpected request: %s", r.URL)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"key":"TEST-1","fields":{"customfield_10010":"sprint","summary":"Fix login"}}`))
+	}))
+	defer server.Close()
+
+	model := initialBoardModel(&Config{JiraURL: server.URL, Projects: []string{"TEST"}})
+	model.loading = false
+	model.width, model.height = 120, 30
+	model.columns[model.selectedCol].issues = []JiraIssue{{Key: "TEST-1"}}
+
+	updated, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'J'}})
+	if cmd == nil {
+		t.Fatal("J should fetch the issue")
+	}
+	updated, _ = updated.(boardModel).Update(cmd())
+	model = updated.(boardModel)
+	if !model.showingHelp {
+		t.Fatal("the JSON should open in the overlay")
+	}
+	if view := model.View(); !strings.Contains(view, `"customfield_10010": "sprint"`) {
+		t.Errorf("overlay should show indented JSON:\n%s", view)
+	}
+
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	model = updated.(boardModel)
+	if model.showingHelp || model.rawJSON != "" {
+		t.Error("esc should close the JSON view")
+	}
+	updated, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'?'}})
+	if view := updated.(boardModel).View(); strings.Contains(view, "customfield") {
+		t.Error("help should not show stale JSON")
+	}
+}
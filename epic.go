@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"gci/internal/httputil"
+	"gci/internal/logger"
+)
+
+// epicTagWidth caps the epic name shown in a board row
+const epicTagWidth = 14
+
+// epicInfo is an epic's display name and board color
+type epicInfo struct {
+	Key   string
+	Name  string
+	Color string // lipgloss color for the epic's JIRA color (color_1..color_14); empty if unknown
+}
+
+// epicColors approximates JIRA's epic label palette
+var epicColors = map[string]string{
+	"color_1":  "#8d542e",
+	"color_2":  "#ff8b00",
+	"color_3":  "#ffc400",
+	"color_4":  "#0065ff",
+	"color_5":  "#6554c0",
+	"color_6":  "#36b37e",
+	"color_7":  "#ff5630",
+	"color_8":  "#4c9aff",
+	"color_9":  "#00b8d9",
+	"color_10": "#00875a",
+	"color_11": "#998dd9",
+	"color_12": "#79e2f2",
+	"color_13": "#57d9a3",
+	"color_14": "#ffe380",
+}
+
+// issueEpicKey returns the epic an issue belongs to: its parent when the parent is an
+// epic (team-managed projects, and company-managed ones since JIRA moved epics to
+// parent), otherwise the classic epic link. Subtasks have no epic of their own.
+func issueEpicKey(issue JiraIssue) string {
+	if issue.Fields.IssueType.Subtask {
+		return ""
+	}
+	parent := issue.Fields.Parent
+	if parent.Key != "" && (parent.Fields.IssueType.HierarchyLevel == 1 || strings.EqualFold(parent.Fields.IssueType.Name, "Epic")) {
+		return parent.Key
+	}
+	return issue.EpicLink
+}
+
+// fetchEpic looks up an epic's name and color through the agile API, falling back to
+// the issue summary for epics without an Epic Name
+func fetchEpic(ctx context.Context, config *Config, key string) (epicInfo, error) {
+	client := httputil.NewDefaultClient()
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/rest/agile/1.0/epic/%s", config.JiraURL, url.PathEscape(key)), nil)
+	if err != nil {
+		return epicInfo{}, err
+	}
+	req.SetBasicAuth(config.Email, config.APIToken)
+	req.Header.Set("Accept", "application/json")
+
+	logger.HTTP("GET", req.URL.String())
+
+	var epic struct {
+		Name    string `json:"name"`
+		Summary string `json:"summary"`
+		Color   struct {
+			Key string `json:"key"`
+		} `json:"color"`
+	}
+	if err := client.DoJSONRequest(ctx, req, &epic); err != nil {
+		return epicInfo{}, err
+	}
+	name := epic.Name
+	if name == "" {
+		name = epic.Summary
+	}
+	return epicInfo{Key: key, Name: name, Color: epicColors[epic.Color.Key]}, nil
+}
+
+// fetchEpics looks up each epic key; epics that fail keep their key as the name
+func fetchEpics(config *Config, keys []string) map[string]epicInfo {
+	ctx, cancel := context.WithTimeout(context.Background(), httputil.Timeout(httputil.DefaultTimeout))
+	defer cancel()
+
+	epics := make(map[string]epicInfo, len(keys))
+	for _, key := range keys {
+		epic, err := fetchEpic(ctx, config, key)
+		if err != nil {
+			logger.JIRA("epic lookup for %s failed: %v", key, err)
+			epic = epicInfo{Key: key, Name: key}
+		}
+		epics[key] = epic
+	}
+	return epics
+}
+
+// sortByEpic groups issues by epic name, issues without an epic last. Order within an
+// epic is unchanged, and subtasks are placed under their parents afterwards.
+func sortByEpic(issues []JiraIssue, epics map[string]epicInfo) []JiraIssue {
+	name := func(it JiraIssue) string {
+		key := issueEpicKey(it)
+		if key == "" {
+			return ""
+		}
+		if epic, ok := epics[key]; ok && epic.Name != "" {
+			return strings.ToLower(epic.Name)
+		}
+		return strings.ToLower(key)
+	}
+	out := make([]JiraIssue, len(issues))
+	copy(out, issues)
+	sort.SliceStable(out, func(i, j int) bool {
+		a, b := name(out[i]), name(out[j])
+		if (a == "") != (b == "") {
+			return b == ""
+		}
+		return a < b
+	})
+	return out
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestIssueEpicKey(t *testing.T) {
+	config := &Config{EpicLinkField: "customfield_10014"}
+	data := `[
+		{"key":"TEST-1","fields":{"parent":{"key":"TEST-100","fields":{"summary":"Checkout","issuetype":{"name":"Epic","hierarchyLevel":1}}}}},
+		{"key":"TEST-2","fields":{"customfield_10014":"TEST-200"}},
+		{"key":"TEST-3","fields":{"issuetype":{"subtask":true},"parent":{"key":"TEST-1","fields":{"issuetype":{"name":"Story"}}}}},
+		{"key":"TEST-4","fields":{"customfield_10014":null}}
+	]`
+	issues := decodeTestIssues(t, config, data)
+
+	want := []string{"TEST-100", "TEST-200", "", ""}
+	for i, issue := range issues {
+		if got := issueEpicKey(issue); got != want[i] {
+			t.Errorf("issueEpicKey(%s) = %q, want %q", issue.Key, got, want[i])
+		}
+	}
+}
+
+// decodeTestIssues decodes a JSON array of issues the way searchJQL does
+func decodeTestIssues(t *testing.T, config *Config, data string) []JiraIssue {
+	t.Helper()
+	var raw []json.RawMessage
+	if err := json.Unmarshal([]byte(data), &raw); err != nil {
+		t.Fatal(err)
+	}
+	var issues []JiraIssue
+	for _, r := range raw {
+		issue, err := decodeIssue(config, r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		issues = append(issues, issue)
+	}
+	return issues
+}
+
+func TestFetchEpic_IntegrationWithMockServer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/agile/1.0/epic/TEST-100":
+			w.Write([]byte(`{"key":"TEST-100","name":"Checkout","summary":"Checkout revamp","color":{"key":"color_4"}}`))
+		case "/rest/agile/1.0/epic/TEST-200":
+			w.Write([]byte(`{"key":"TEST-200","name":"","summary":"Search"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{JiraURL: server.URL, Email: "test@example.com", APIToken: "test-token"}
+	epic, err := fetchEpic(context.Background(), config, "TEST-100")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if epic.Name != "Checkout" || epic.Color != epicColors["color_4"] {
+		t.Errorf("unexpected epic: %+v", epic)
+	}
+
+	epics := fetchEpics(config, []string{"TEST-200", "TEST-404"})
+	if epics["TEST-200"].Name != "Search" {
+		t.Errorf("epic without an Epic Name should use its summary, got %+v", epics["TEST-200"])
+	}
+	if epics["TEST-404"].Name != "TEST-404" {
+		t.Errorf("failed lookups should fall back to the key, got %+v", epics["TEST-404"])
+	}
+}
+
+func TestBoardModel_EpicGrouping(t *testing.T) {
+	cfg := &Config{JiraURL: "https://test.atlassian.net", Projects: []string{"TEST"}, ShowEpics: true}
+	model := initialBoardModel(cfg)
+	model.width, model.height = 160, 30
+
+	issue := func(key, epic string) JiraIssue {
+		var it JiraIssue
+		it.Key = key
+		it.Fields.Summary = "Work on " + key
+		it.EpicLink = epic
+		return it
+	}
+	issues := []JiraIssue{issue("TEST-1", ""), issue("TEST-2", "TEST-200"), issue("TEST-3", "TEST-100")}
+	model.columns[0].allIssues = issues
+	model.columns[0].issues = issues
+	model.epics["TEST-100"] = epicInfo{Key: "TEST-100", Name: "Checkout"}
+	model.epics["TEST-200"] = epicInfo{Key: "TEST-200", Name: "Search"}
+
+	if tag, _ := model.epicTag(issues[2]); tag != "‹Checkout› " {
+		t.Errorf("epicTag = %q", tag)
+	}
+	if view := model.View(); !strings.Contains(view, "‹Search› TEST-2") {
+		t.Errorf("rows should carry the epic tag:\n%s", view)
+	}
+
+	updated, _ := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'E'}})
+	model = updated.(boardModel)
+	var got []string
+	for _, it := range model.columns[0].issues {
+		got = append(got, it.Key)
+	}
+	if strings.Join(got, ",") != "TEST-3,TEST-2,TEST-1" {
+		t.Errorf("E should group by epic name with unassigned issues last, got %v", got)
+	}
+
+	model.cfg.ShowEpics = false
+	if tag, _ := model.epicTag(issues[2]); tag != "" {
+		t.Errorf("epics off should drop the tag, got %q", tag)
+	}
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"gci/internal/jira"
+)
+
+// TestWriteIssuesCSV verifies the header row and quoting of awkward summaries
+func TestWriteIssuesCSV(t *testing.T) {
+	config := &Config{JiraURL: "https://test.atlassian.net"}
+
+	issue := JiraIssue{Key: "TEST-1"}
+	issue.Fields.Summary = `Fix "login", then logout`
+	issue.Fields.Status.Name = "In Progress"
+	issue.Fields.Assignee.DisplayName = "Test User"
+	issue.Fields.Priority.Name = "High"
+
+	var buf bytes.Buffer
+	if err := writeIssuesCSV(&buf, config, []JiraIssue{issue}); err != nil {
+		t.Fatalf("writeIssuesCSV failed: %v", err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("Output is not valid CSV: %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("Expected header + 1 row, got %d rows", len(records))
+	}
+
+	expectedHeader := []string{"key", "status", "assignee", "priority", "summary", "url"}
+	for i, col := range expectedHeader {
+		if records[0][i] != col {
+			t.Errorf("Header column %d: expected %q, got %q", i, col, records[0][i])
+		}
+	}
+
+	expectedRow := []string{"TEST-1", "In Progress", "Test User", "High", `Fix "login", then logout`, "https://test.atlassian.net/browse/TEST-1"}
+	for i, val := range expectedRow {
+		if records[1][i] != val {
+			t.Errorf("Row column %d: expected %q, got %q", i, val, records[1][i])
+		}
+	}
+}
+
+// TestWriteIssuesChecklist verifies statuses are grouped To Do first, Done is checked,
+// and keys link to JIRA with links on
+func TestWriteIssuesChecklist(t *testing.T) {
+	config := &Config{JiraURL: "https://test.atlassian.net"}
+	mk := func(key, summary, status, category string) JiraIssue {
+		issue := JiraIssue{Key: key}
+		issue.Fields.Summary = summary
+		issue.Fields.Status.Name = status
+		issue.Fields.Status.StatusCategory.Key = category
+		return issue
+	}
+	issues := []JiraIssue{
+		mk("TEST-1", "Ship it", "Done", jira.StatusCategoryDone),
+		mk("TEST-2", "Write docs", "In Progress", jira.StatusCategoryIndeterminate),
+		mk("TEST-3", "Plan", "Open", jira.StatusCategoryNew),
+		mk("TEST-4", "Review", "In Progress", jira.StatusCategoryIndeterminate),
+	}
+
+	var buf bytes.Buffer
+	writeIssuesChecklist(&buf, config, issues, false)
+	want := `## Open
+
+- [ ] TEST-3 Plan
+
+## In Progress
+
+- [ ] TEST-2 Write docs
+- [ ] TEST-4 Review
+
+## Done
+
+- [x] TEST-1 Ship it
+`
+	if buf.String() != want {
+		t.Errorf("checklist:\n%s\nwant:\n%s", buf.String(), want)
+	}
+
+	buf.Reset()
+	writeIssuesChecklist(&buf, config, issues[:1], true)
+	if want := "- [x] [TEST-1](https://test.atlassian.net/browse/TEST-1) Ship it\n"; !bytes.HasSuffix(buf.Bytes(), []byte(want)) {
+		t.Errorf("linked checklist = %q, want suffix %q", buf.String(), want)
+	}
+}
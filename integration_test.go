@@ -1,11 +1,16 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
+	stderrors "errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"gci/internal/errors"
 	"gci/internal/jira"
 )
 
@@ -20,72 +25,15 @@ type mockJiraResponse struct {
 // TestFetchColumnIssues_IntegrationWithMockServer tests fetchColumnIssues with a test server
 func TestFetchColumnIssues_IntegrationWithMockServer(t *testing.T) {
 	// Create mock JIRA issues
-	mockIssues := []JiraIssue{
-		{
-			Key: "TEST-123",
-			Fields: struct {
-				Summary     string `json:"summary"`
-				Description *struct {
-					Content []struct {
-						Type    string `json:"type"`
-						Content []struct {
-							Type string `json:"type"`
-							Text string `json:"text,omitempty"`
-						} `json:"content,omitempty"`
-					} `json:"content,omitempty"`
-				} `json:"description"`
-				Project struct {
-					Key string `json:"key"`
-				} `json:"project"`
-				IssueType struct {
-					Name    string `json:"name"`
-					Subtask bool   `json:"subtask"`
-				} `json:"issuetype"`
-				Parent struct {
-					Key string `json:"key"`
-				} `json:"parent"`
-				Status struct {
-					Name           string `json:"name"`
-					StatusCategory struct {
-						Name string `json:"name"`
-					} `json:"statusCategory"`
-				} `json:"status"`
-				Assignee struct {
-					DisplayName string `json:"displayName"`
-					Name        string `json:"name"`
-				} `json:"assignee"`
-				Priority struct {
-					Name string `json:"name"`
-				} `json:"priority"`
-			}{
-				Summary: "Test issue for integration test",
-				Project: struct {
-					Key string `json:"key"`
-				}{Key: "TEST"},
-				Status: struct {
-					Name           string `json:"name"`
-					StatusCategory struct {
-						Name string `json:"name"`
-					} `json:"statusCategory"`
-				}{
-					Name: "To Do",
-					StatusCategory: struct {
-						Name string `json:"name"`
-					}{Name: "To Do"},
-				},
-				Assignee: struct {
-					DisplayName string `json:"displayName"`
-					Name        string `json:"name"`
-				}{
-					DisplayName: "Test User",
-					Name:        "testuser",
-				},
-				Priority: struct {
-					Name string `json:"name"`
-				}{Name: "Medium"},
-			},
-		},
-	}
+	mockIssue := JiraIssue{Key: "TEST-123"}
+	mockIssue.Fields.Summary = "Test issue for integration test"
+	mockIssue.Fields.Project.Key = "TEST"
+	mockIssue.Fields.Status.Name = "To Do"
+	mockIssue.Fields.Status.StatusCategory.Name = "To Do"
+	mockIssue.Fields.Assignee.DisplayName = "Test User"
+	mockIssue.Fields.Assignee.Name = "testuser"
+	mockIssue.Fields.Priority.Name = "Medium"
+	mockIssues := []JiraIssue{mockIssue}
 
 	// Create test server
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -139,62 +87,12 @@ func TestFetchColumnIssues_IntegrationWithMockServer(t *testing.T) {
 
 // TestFetchIssuesWithJQL_IntegrationWithMockServer tests fetchIssuesWithJQL with a test server
 func TestFetchIssuesWithJQL_IntegrationWithMockServer(t *testing.T) {
-	mockIssues := []JiraIssue{
-		{
-			Key: "PROJ-456",
-			Fields: struct {
-				Summary     string `json:"summary"`
-				Description *struct {
-					Content []struct {
-						Type    string `json:"type"`
-						Content []struct {
-							Type string `json:"type"`
-							Text string `json:"text,omitempty"`
-						} `json:"content,omitempty"`
-					} `json:"content,omitempty"`
-				} `json:"description"`
-				Project struct {
-					Key string `json:"key"`
-				} `json:"project"`
-				IssueType struct {
-					Name    string `json:"name"`
-					Subtask bool   `json:"subtask"`
-				} `json:"issuetype"`
-				Parent struct {
-					Key string `json:"key"`
-				} `json:"parent"`
-				Status struct {
-					Name           string `json:"name"`
-					StatusCategory struct {
-						Name string `json:"name"`
-					} `json:"statusCategory"`
-				} `json:"status"`
-				Assignee struct {
-					DisplayName string `json:"displayName"`
-					Name        string `json:"name"`
-				} `json:"assignee"`
-				Priority struct {
-					Name string `json:"name"`
-				} `json:"priority"`
-			}{
-				Summary: "JQL test issue",
-				Project: struct {
-					Key string `json:"key"`
-				}{Key: "PROJ"},
-				Status: struct {
-					Name           string `json:"name"`
-					StatusCategory struct {
-						Name string `json:"name"`
-					} `json:"statusCategory"`
-				}{
-					Name: "In Progress",
-					StatusCategory: struct {
-						Name string `json:"name"`
-					}{Name: "In Progress"},
-				},
-			},
-		},
-	}
+	mockIssue := JiraIssue{Key: "PROJ-456"}
+	mockIssue.Fields.Summary = "JQL test issue"
+	mockIssue.Fields.Project.Key = "PROJ"
+	mockIssue.Fields.Status.Name = "In Progress"
+	mockIssue.Fields.Status.StatusCategory.Name = "In Progress"
+	mockIssues := []JiraIssue{mockIssue}
 
 	// Track received JQL query
 	var receivedJQL string
@@ -376,4 +274,316 @@ func TestHTTPErrorHandling_IntegrationWithMockServer(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+// TestFetchComponentMeta_IntegrationWithMockServer tests createmeta parsing for required components
+func TestFetchComponentMeta_IntegrationWithMockServer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/api/3/issue/createmeta/PROJ/issuetypes":
+			w.Write([]byte(`{"issueTypes":[{"id":"10001","name":"Bug"},{"id":"10002","name":"Task"}]}`))
+		case "/rest/api/3/issue/createmeta/PROJ/issuetypes/10002":
+			w.Write([]byte(`{"fields":[
+				{"fieldId":"summary","required":true},
+				{"fieldId":"components","required":true,"allowedValues":[{"name":"Backend"},{"name":"Frontend"}]}
+			]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{
+		JiraURL:  server.URL,
+		Email:    "test@example.com",
+		APIToken: "test-token",
+	}
+
+	meta, err := fetchComponentMeta(config, "PROJ", "task")
+	if err != nil {
+		t.Fatalf("fetchComponentMeta failed: %v", err)
+	}
+	if !meta.Required {
+		t.Error("Expected components to be required")
+	}
+	if len(meta.Allowed) != 2 || meta.Allowed[0] != "Backend" || meta.Allowed[1] != "Frontend" {
+		t.Errorf("Expected allowed components [Backend Frontend], got %v", meta.Allowed)
+	}
+
+	if _, err := fetchComponentMeta(config, "PROJ", "Epic"); err == nil {
+		t.Error("Expected error for unknown issue type")
+	}
+}
+
+func TestValidateIssueType_IntegrationWithMockServer(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/rest/api/3/issue/createmeta/PROJ/issuetypes" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"issueTypes":[{"id":"10001","name":"Bug"},{"id":"10002","name":"Task"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{
+		JiraURL:  server.URL,
+		Email:    "test@example.com",
+		APIToken: "test-token",
+	}
+
+	issueType, err := validateIssueType(config, "PROJ", "bug")
+	if err != nil {
+		t.Fatalf("validateIssueType failed: %v", err)
+	}
+	if issueType != "Bug" {
+		t.Errorf("Expected canonical name 'Bug', got %q", issueType)
+	}
+
+	// Without a terminal the prompt fails, so the error should list the valid types
+	_, err = validateIssueType(config, "PROJ", "Story")
+	if err == nil || !strings.Contains(err.Error(), "Bug, Task") {
+		t.Errorf("Expected error listing valid types, got %v", err)
+	}
+
+	if requests != 1 {
+		t.Errorf("Expected createmeta to be fetched once and cached, got %d requests", requests)
+	}
+}
+
+func TestDoIssueWrite_ConflictHandling(t *testing.T) {
+	writes := 0
+	conflicts := 1
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/rest/api/3/issue/TEST-1":
+			w.Write([]byte(`{"key":"TEST-1","fields":{"status":{"name":"To Do"}}}`))
+		case r.Method == "PUT" && r.URL.Path == "/rest/api/3/issue/TEST-1/assignee":
+			writes++
+			if writes <= conflicts {
+				w.WriteHeader(http.StatusConflict)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{JiraURL: server.URL, Email: "test@example.com", APIToken: "test-token"}
+	write := issueWrite{
+		Key:    "TEST-1",
+		Method: "PUT",
+		Path:   "/assignee",
+		Body:   map[string]string{"accountId": "abc"},
+		Retry:  func(current JiraIssue) bool { return current.Fields.Status.Name == "To Do" },
+	}
+
+	if err := doIssueWrite(config, write); err != nil {
+		t.Fatalf("Expected retry after 409 to succeed, got %v", err)
+	}
+	if writes != 2 {
+		t.Errorf("Expected 2 write attempts, got %d", writes)
+	}
+
+	// Without a Retry func the conflict is surfaced instead of re-sent
+	writes, conflicts = 0, 1
+	write.Retry = nil
+	err := doIssueWrite(config, write)
+	if !stderrors.Is(err, errors.ErrIssueChanged) {
+		t.Errorf("Expected ErrIssueChanged, got %v", err)
+	}
+	if writes != 1 {
+		t.Errorf("Expected a single write attempt, got %d", writes)
+	}
+
+	// Retries are bounded even when the conflict persists
+	writes, conflicts = 0, 10
+	write.Retry = func(JiraIssue) bool { return true }
+	if err := doIssueWrite(config, write); !stderrors.Is(err, errors.ErrIssueChanged) {
+		t.Errorf("Expected ErrIssueChanged after exhausting retries, got %v", err)
+	}
+	if writes != issueWriteRetries+1 {
+		t.Errorf("Expected %d write attempts, got %d", issueWriteRetries+1, writes)
+	}
+}
+
+func TestTransitionIssue_IntegrationWithMockServer(t *testing.T) {
+	var posted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/rest/api/3/issue/TEST-1/transitions":
+			w.Write([]byte(`{"transitions":[{"id":"11","name":"Start Progress","to":{"name":"In Progress"}}]}`))
+		case r.Method == "POST" && r.URL.Path == "/rest/api/3/issue/TEST-1/transitions":
+			var body struct {
+				Transition struct {
+					ID string `json:"id"`
+				} `json:"transition"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			posted = append(posted, body.Transition.ID)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{JiraURL: server.URL, Email: "test@example.com", APIToken: "test-token"}
+	issue := JiraIssue{Key: "TEST-1"}
+	issue.Fields.Status.Name = "To Do"
+
+	skipped, reason, err := transitionIssue(config, issue, "In Progress")
+	if err != nil || skipped {
+		t.Fatalf("Expected transition, got skipped=%v err=%v", skipped, err)
+	}
+	if len(posted) != 1 || posted[0] != "11" || reason != "TEST-1 moved to In Progress" {
+		t.Errorf("Unexpected transition: posted=%v reason=%q", posted, reason)
+	}
+
+	if skipped, _, err := transitionIssue(config, issue, "Blocked"); err != nil || !skipped {
+		t.Errorf("Expected unavailable transition to be skipped, got skipped=%v err=%v", skipped, err)
+	}
+
+	issue.Fields.Status.Name = "In Progress"
+	if skipped, _, _ := transitionIssue(config, issue, "in progress"); !skipped || len(posted) != 1 {
+		t.Error("Expected an issue already in the target status to be skipped without a request")
+	}
+}
+
+func TestSearchJQL_Pagination(t *testing.T) {
+	page := func(keys ...string) []JiraIssue {
+		var issues []JiraIssue
+		for _, k := range keys {
+			issues = append(issues, JiraIssue{Key: k})
+		}
+		return issues
+	}
+
+	t.Run("nextPageToken", func(t *testing.T) {
+		var tokens []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token := r.URL.Query().Get("nextPageToken")
+			tokens = append(tokens, token)
+			resp := JiraResponse{Issues: page("A-1", "A-2"), NextPageToken: "p2"}
+			if token == "p2" {
+				resp = JiraResponse{Issues: page("A-3"), IsLast: true}
+			}
+			json.NewEncoder(w).Encode(resp)
+		}))
+		defer server.Close()
+
+		config := &Config{JiraURL: server.URL, Email: "test@example.com", APIToken: "test-token"}
+		issues, err := searchJQL(context.Background(), config, "project = A", "summary", 10)
+		if err != nil {
+			t.Fatalf("searchJQL failed: %v", err)
+		}
+		if len(issues) != 3 || len(tokens) != 2 || tokens[1] != "p2" {
+			t.Errorf("Expected 3 issues over 2 token pages, got %d issues, tokens %v", len(issues), tokens)
+		}
+	})
+
+	t.Run("startAt", func(t *testing.T) {
+		var starts []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := r.URL.Query().Get("startAt")
+			starts = append(starts, start)
+			resp := JiraResponse{Issues: page("B-1", "B-2"), Total: 5}
+			if start == "2" {
+				resp = JiraResponse{Issues: page("B-3", "B-4"), StartAt: 2, Total: 5}
+			}
+			json.NewEncoder(w).Encode(resp)
+		}))
+		defer server.Close()
+
+		config := &Config{JiraURL: server.URL, Email: "test@example.com", APIToken: "test-token"}
+		issues, err := searchJQL(context.Background(), config, "project = B", "summary", 4)
+		if err != nil {
+			t.Fatalf("searchJQL failed: %v", err)
+		}
+		if len(issues) != 4 || len(starts) != 2 || starts[1] != "2" {
+			t.Errorf("Expected 4 issues over 2 offset pages, got %d issues, startAt %v", len(issues), starts)
+		}
+	})
+
+	t.Run("custom fields", func(t *testing.T) {
+		var fields string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fields = r.URL.Query().Get("fields")
+			w.Write([]byte(`{"isLast":true,"issues":[{"key":"C-1","fields":{"customfield_10014":"C-100","customfield_10021":[{"value":"Impediment"}]}}]}`))
+		}))
+		defer server.Close()
+
+		config := &Config{JiraURL: server.URL, Email: "test@example.com", APIToken: "test-token",
+			EpicLinkField: "customfield_10014", FlaggedField: "customfield_10021"}
+		issues, err := searchJQL(context.Background(), config, "project = C", getFieldsList(config), 10)
+		if err != nil {
+			t.Fatalf("searchJQL failed: %v", err)
+		}
+		if !strings.Contains(fields, "customfield_10014") || !strings.Contains(fields, "customfield_10021") {
+			t.Errorf("Expected the configured custom fields to be requested, got %q", fields)
+		}
+		if len(issues) != 1 || issues[0].EpicLink != "C-100" || !issues[0].Flagged {
+			t.Errorf("Expected the epic link and flag from the config's fields, got %+v", issues)
+		}
+	})
+}
+
+func TestIsUnknownModelError(t *testing.T) {
+	cases := []struct {
+		output string
+		want   bool
+	}{
+		{`API Error: 404 {"type":"error","error":{"type":"not_found_error","message":"model: gpt4"}}`, true},
+		{"There's an issue with the selected model (gpt4). It may not exist or you may not have access to it.", true},
+		{"Error: Invalid model name gpt4", true},
+		{"Invalid API key · Please run /login", false},
+		{"Error: connect ECONNREFUSED", false},
+	}
+	for _, tc := range cases {
+		if got := isUnknownModelError(tc.output); got != tc.want {
+			t.Errorf("isUnknownModelError(%q) = %v, want %v", tc.output, got, tc.want)
+		}
+	}
+}
+
+func TestFetchIssuesByKeys_ChunksAtBatchBoundary(t *testing.T) {
+	var batchSizes []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys := strings.Split(strings.TrimSuffix(strings.TrimPrefix(r.URL.Query().Get("jql"), "key in ("), ")"), ", ")
+		batchSizes = append(batchSizes, len(keys))
+		resp := JiraResponse{IsLast: true}
+		for i := len(keys) - 1; i >= 0; i-- {
+			resp.Issues = append(resp.Issues, JiraIssue{Key: keys[i]})
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+	config := &Config{JiraURL: server.URL, Email: "test@example.com", APIToken: "test-token"}
+
+	var keys []string
+	for i := 1; i <= issueKeyBatchSize+1; i++ {
+		keys = append(keys, fmt.Sprintf("TEST-%d", i))
+	}
+	found, err := fetchIssuesByKeys(config, append(keys, "TEST-1"), "status")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(batchSizes) != 2 || batchSizes[0] != issueKeyBatchSize || batchSizes[1] != 1 {
+		t.Errorf("expected a full batch and a single-key batch, got sizes %v", batchSizes)
+	}
+	if len(found) != len(keys) {
+		t.Errorf("expected each issue once, got %d issues", len(found))
+	}
+
+	batchSizes = nil
+	if _, err := fetchIssuesByKeys(config, keys[:issueKeyBatchSize], "status"); err != nil || len(batchSizes) != 1 {
+		t.Errorf("exactly issueKeyBatchSize keys should be one request, got sizes %v (err %v)", batchSizes, err)
+	}
+}
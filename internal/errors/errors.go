@@ -1,16 +1,40 @@
 package errors
 
 import (
+	"context"
+	stderrors "errors"
 	"fmt"
+	"net"
 	"strings"
 )
 
+// Process exit codes, so scripts can tell why gci failed
+const (
+	ExitOK            = 0
+	ExitError         = 1 // anything not covered below
+	ExitNotConfigured = 2 // no config file/env, or missing git identity
+	ExitAuth          = 3 // missing or rejected JIRA credentials
+	ExitNetwork       = 4 // JIRA unreachable, timing out, or returning 5xx
+	ExitNoResults     = 5 // the query ran but matched nothing
+)
+
+// Sentinel causes for 1Password CLI failures, used to tailor remediation
+var (
+	ErrOnePasswordNotSignedIn  = fmt.Errorf("1Password CLI is not signed in")
+	ErrOnePasswordItemNotFound = fmt.Errorf("1Password item not found")
+)
+
+// ErrIssueChanged is the cause of errors for writes JIRA rejected with 409 because the
+// issue was modified concurrently
+var ErrIssueChanged = fmt.Errorf("issue was modified concurrently")
+
 // UserError represents an error with user-friendly messaging and remediation hints
 type UserError struct {
 	Title       string // Brief title of the error
 	Message     string // Detailed error message
 	Remediation string // What the user can do to fix it
 	Cause       error  // Underlying error, if any
+	Code        int    // Process exit code; 0 means ExitError
 }
 
 func (e *UserError) Error() string {
@@ -35,6 +59,23 @@ func (e *UserError) Unwrap() error {
 	return e.Cause
 }
 
+// ExitCode maps err to the process exit code: the Code of a wrapped UserError if set,
+// ExitNetwork for network failures and timeouts, and ExitError otherwise
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitOK
+	}
+	var userErr *UserError
+	if stderrors.As(err, &userErr) && userErr.Code != 0 {
+		return userErr.Code
+	}
+	var netErr net.Error
+	if stderrors.As(err, &netErr) || stderrors.Is(err, context.DeadlineExceeded) {
+		return ExitNetwork
+	}
+	return ExitError
+}
+
 // Common error constructors with built-in remediation
 
 func NewGitConfigError(err error) *UserError {
@@ -43,15 +84,85 @@ func NewGitConfigError(err error) *UserError {
 		Message:     "Failed to get git user email configuration.",
 		Remediation: "Run: git config --global user.email \"your.email@example.com\"",
 		Cause:       err,
+		Code:        ExitNotConfigured,
 	}
 }
 
-func NewOnePasswordError() *UserError {
+// NewInvalidEmailError reports an --email or jira_email value that is not an address
+func NewInvalidEmailError(email string) *UserError {
+	return &UserError{
+		Title:       "❌ Invalid Email",
+		Message:     fmt.Sprintf("'%s' is not a valid email address.", email),
+		Remediation: "Pass --email you@example.com, or run: gci config set jira_email you@example.com",
+		Code:        ExitNotConfigured,
+	}
+}
+
+func NewNotConfiguredError() *UserError {
+	return &UserError{
+		Title:       "GCI is not configured yet.",
+		Message:     "Set GCI_JIRA_URL=https://your-company.atlassian.net and GCI_PROJECTS=PROJ1,PROJ2, or create a config file.",
+		Remediation: "Run: gci setup",
+		Code:        ExitNotConfigured,
+	}
+}
+
+// NewNoResultsError reports a query that succeeded but matched nothing
+func NewNoResultsError(message string) *UserError {
+	return &UserError{
+		Message: message,
+		Code:    ExitNoResults,
+	}
+}
+
+// NewOnePasswordError reports a missing JIRA API token. cause may be nil, or wrap
+// ErrOnePasswordNotSignedIn / ErrOnePasswordItemNotFound for targeted remediation.
+func NewOnePasswordError(cause error) *UserError {
+	switch {
+	case stderrors.Is(cause, ErrOnePasswordNotSignedIn):
+		return &UserError{
+			Title:       "Authentication Error",
+			Message:     "1Password CLI is not signed in (or the session expired).",
+			Remediation: "Run: op signin, then retry. Alternatively set JIRA_API_TOKEN env var",
+			Cause:       cause,
+			Code:        ExitAuth,
+		}
+	case stderrors.Is(cause, ErrOnePasswordItemNotFound):
+		return &UserError{
+			Title:       "Authentication Error",
+			Message:     "The 1Password item for the JIRA API token was not found.",
+			Remediation: "Check op_jira_token_path in ~/.config/gci/config.toml, or re-run: gci setup",
+			Cause:       cause,
+			Code:        ExitAuth,
+		}
+	}
 	return &UserError{
 		Title:       "Authentication Error",
 		Message:     "No JIRA API token found.",
 		Remediation: "Set JIRA_API_TOKEN env var, or configure op_jira_token_path in ~/.config/gci/config.toml and run: op signin",
-		Cause:       nil,
+		Cause:       cause,
+		Code:        ExitAuth,
+	}
+}
+
+// NewInteractiveInputError reports a prompt that can't be shown because stdin isn't a
+// terminal (CI, pipes); hint names the flags that answer it instead
+func NewInteractiveInputError(what, hint string) *UserError {
+	return &UserError{
+		Title:       "Interactive input required",
+		Message:     fmt.Sprintf("gci needs to ask for %s, but stdin is not a terminal.", what),
+		Remediation: hint,
+	}
+}
+
+// NewBranchCollisionError reports an existing branch that doesn't look like work on its
+// issue when there is no terminal to ask whether to take it over; alt is a free name
+func NewBranchCollisionError(branch, alt string, cause error) *UserError {
+	return &UserError{
+		Title:       "Branch already exists",
+		Message:     fmt.Sprintf("Branch %s already exists but doesn't look related to this issue, so gci left it alone.", branch),
+		Remediation: fmt.Sprintf("Create %s instead (git checkout -b %s), check out %s yourself, or run gci in a terminal to choose", alt, alt, branch),
+		Cause:       cause,
 	}
 }
 
@@ -67,13 +178,16 @@ func NewInvalidProjectError(project string, available []string) *UserError {
 func NewJiraConnectionError(err error) *UserError {
 	errStr := err.Error()
 	var remediation string
+	code := ExitNetwork
 	
 	if strings.Contains(errStr, "401") || strings.Contains(errStr, "Unauthorized") {
 		remediation = "Check your API token in 1Password. Run: op signin && gci config doctor"
+		code = ExitAuth
 	} else if strings.Contains(errStr, "timeout") || strings.Contains(errStr, "no such host") {
 		remediation = "Check your internet connection and JIRA URL. Run: gci config doctor"
 	} else if strings.Contains(errStr, "403") || strings.Contains(errStr, "Forbidden") {
 		remediation = "Your API token lacks permission for this operation. Contact your JIRA administrator"
+		code = ExitAuth
 	} else {
 		remediation = "Run: gci config doctor to diagnose the issue"
 	}
@@ -83,6 +197,18 @@ func NewJiraConnectionError(err error) *UserError {
 		Message:     "Failed to connect to JIRA. " + errStr,
 		Remediation: remediation,
 		Cause:       err,
+		Code:        code,
+	}
+}
+
+// NewHTMLResponseError reports a JIRA request answered with an HTML page instead of
+// JSON, usually an SSO proxy's login page after the session expired
+func NewHTMLResponseError(host string) *UserError {
+	return &UserError{
+		Title:       "❌ Unexpected JIRA Response",
+		Message:     fmt.Sprintf("%s returned an HTML page, not JSON — you may be behind an SSO proxy that intercepted the request.", host),
+		Remediation: "Check your JIRA URL and credentials (sign in to the proxy in a browser if it needs one). Run: gci config doctor",
+		Code:        ExitAuth,
 	}
 }
 
@@ -104,6 +230,17 @@ func NewJQLPresetNotFoundError(preset string) *UserError {
 	}
 }
 
+// NewIssueChangedError reports a write that conflicted with a concurrent change to key
+// and could not be safely retried against the refetched issue.
+func NewIssueChangedError(key string) *UserError {
+	return &UserError{
+		Title:       "Issue Changed",
+		Message:     fmt.Sprintf("%s was changed on JIRA while you were editing it.", key),
+		Remediation: "Refresh (r on the board) and try again",
+		Cause:       ErrIssueChanged,
+	}
+}
+
 func NewConfigError(operation string, err error) *UserError {
 	var remediation string
 	errStr := err.Error()
@@ -138,20 +275,24 @@ func NewBoardDiscoveryError(err error) *UserError {
 
 func NewHttpError(statusCode int, body string) *UserError {
 	var title, remediation string
+	code := ExitError
 	
 	switch {
 	case statusCode == 401:
 		title = "❌ Authentication Failed"
 		remediation = "Check your API token. Run: op signin && gci config doctor"
+		code = ExitAuth
 	case statusCode == 403:
 		title = "❌ Access Forbidden" 
 		remediation = "Your account lacks permission for this operation. Contact your JIRA administrator"
+		code = ExitAuth
 	case statusCode == 404:
 		title = "❌ Resource Not Found"
 		remediation = "The requested JIRA resource was not found. Check your project configuration"
 	case statusCode >= 500:
 		title = "❌ Server Error"
 		remediation = "JIRA server is experiencing issues. Try again later or contact your administrator"
+		code = ExitNetwork
 	default:
 		title = "❌ HTTP Error"
 		remediation = "An unexpected HTTP error occurred. Run: gci --verbose to see detailed logs"
@@ -162,6 +303,7 @@ func NewHttpError(statusCode int, body string) *UserError {
 		Message:     fmt.Sprintf("HTTP %d: %s", statusCode, body),
 		Remediation: remediation,
 		Cause:       nil,
+		Code:        code,
 	}
 }
 
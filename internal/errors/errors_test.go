@@ -1,6 +1,7 @@
 package errors
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
@@ -78,7 +79,7 @@ func TestNewGitConfigError(t *testing.T) {
 }
 
 func TestNewOnePasswordError(t *testing.T) {
-	err := NewOnePasswordError()
+	err := NewOnePasswordError(nil)
 
 	result := err.Error()
 
@@ -96,6 +97,40 @@ func TestNewOnePasswordError(t *testing.T) {
 	}
 }
 
+func TestNewOnePasswordError_TailoredCauses(t *testing.T) {
+	tests := []struct {
+		name     string
+		cause    error
+		expected []string
+	}{
+		{
+			name:     "not signed in",
+			cause:    fmt.Errorf("%w: session expired", ErrOnePasswordNotSignedIn),
+			expected: []string{"not signed in", "💡 Run: op signin"},
+		},
+		{
+			name:     "item not found",
+			cause:    fmt.Errorf("%w: isn't an item", ErrOnePasswordItemNotFound),
+			expected: []string{"was not found", "op_jira_token_path", "gci setup"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewOnePasswordError(tt.cause)
+			result := err.Error()
+			for _, part := range tt.expected {
+				if !strings.Contains(result, part) {
+					t.Errorf("Expected error message to contain %q, but got: %s", part, result)
+				}
+			}
+			if err.Unwrap() != tt.cause {
+				t.Errorf("Expected Unwrap() to return the cause")
+			}
+		})
+	}
+}
+
 func TestNewInvalidProjectError(t *testing.T) {
 	err := NewInvalidProjectError("BADPROJ", []string{"GOOD1", "GOOD2"})
 	
@@ -235,10 +270,36 @@ func TestWrapWithContext(t *testing.T) {
 
 func TestWrapWithContext_AlreadyUserError(t *testing.T) {
 	// Test that wrapping a UserError returns it unchanged
-	original := NewOnePasswordError()
+	original := NewOnePasswordError(nil)
 	wrapped := WrapWithContext(original, "some_context")
 	
 	if wrapped != original {
 		t.Error("Expected WrapWithContext to return the same UserError unchanged")
 	}
-}
\ No newline at end of file
+}
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"nil", nil, ExitOK},
+		{"plain error", fmt.Errorf("boom"), ExitError},
+		{"not configured", NewNotConfiguredError(), ExitNotConfigured},
+		{"missing token", NewOnePasswordError(nil), ExitAuth},
+		{"rejected token", NewHttpError(401, ""), ExitAuth},
+		{"bad request", NewHttpError(400, ""), ExitError},
+		{"server error", NewHttpError(503, ""), ExitNetwork},
+		{"connection", NewJiraConnectionError(fmt.Errorf("dial tcp: no such host")), ExitNetwork},
+		{"no results", NewNoResultsError("nothing"), ExitNoResults},
+		{"wrapped", fmt.Errorf("failed to fetch: %w", NewHttpError(401, "")), ExitAuth},
+		{"deadline", fmt.Errorf("request: %w", context.DeadlineExceeded), ExitNetwork},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExitCode(tt.err); got != tt.want {
+				t.Errorf("ExitCode() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
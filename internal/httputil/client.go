@@ -1,19 +1,66 @@
 package httputil
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
+	"sync/atomic"
 	"time"
 	
 	"gci/internal/errors"
+	"gci/internal/logger"
 )
 
 // DefaultTimeout is the standard timeout for HTTP requests
 const DefaultTimeout = 30 * time.Second
 
+// MinTimeout is the shortest timeout SetTimeout accepts; anything less can't finish
+// a TLS handshake and a request
+const MinTimeout = time.Second
+
+// operationFloorDivisor bounds how far --timeout can shorten an operation: to a third of
+// its own timeout, so a 30s search or board load keeps at least 10s
+const operationFloorDivisor = 3
+
+// timeoutOverride replaces every operation's own timeout when set (--timeout)
+var timeoutOverride atomic.Int64
+
+// SetTimeout sets the timeout for every request (--timeout / GCI_TIMEOUT), longer or
+// shorter than the operations' own; Timeout keeps each operation's minimum. 0 restores
+// each operation's own timeout.
+func SetTimeout(d time.Duration) {
+	if d > 0 && d < MinTimeout {
+		d = MinTimeout
+	}
+	timeoutOverride.Store(int64(d))
+}
+
+// Timeout returns the timeout for an operation whose own timeout is d: the --timeout
+// value when one is set, but no less than the operation's minimum (a third of d, at
+// least MinTimeout), else d
+func Timeout(d time.Duration) time.Duration {
+	override := time.Duration(timeoutOverride.Load())
+	if override <= 0 {
+		return d
+	}
+	floor := min(d, max(d/operationFloorDivisor, MinTimeout))
+	return max(override, floor)
+}
+
+// transport is shared by every gci HTTP client, so proxy settings (HTTPS_PROXY,
+// NO_PROXY) and the system CA pool (SSL_CERT_FILE) apply the same way everywhere
+var transport = http.DefaultTransport.(*http.Transport).Clone()
+
+// Transport returns gci's shared transport, for clients built outside this package
+func Transport() http.RoundTripper {
+	return transport
+}
+
 // RetryableClient provides HTTP operations with consistent timeout and retry behavior
 type RetryableClient struct {
 	client  *http.Client
@@ -23,10 +70,9 @@ type RetryableClient struct {
 
 // NewRetryableClient creates a new HTTP client with timeout and retry configuration
 func NewRetryableClient(timeout time.Duration, retries int) *RetryableClient {
+	timeout = Timeout(timeout)
 	return &RetryableClient{
-		client: &http.Client{
-			Timeout: timeout,
-		},
+		client:  &http.Client{Timeout: timeout, Transport: transport},
 		timeout: timeout,
 		retries: retries,
 	}
@@ -46,11 +92,14 @@ func (c *RetryableClient) DoWithRetry(ctx context.Context, req *http.Request) (*
 		defer cancel()
 	}
 
+	logRequestBody(req)
+
 	var lastErr error
 	
 	for attempt := 0; attempt <= c.retries; attempt++ {
 		// Clone request with context
 		reqWithCtx := req.Clone(ctx)
+		applyHeaders(reqWithCtx)
 		
 		resp, err := c.client.Do(reqWithCtx)
 		if err != nil {
@@ -83,12 +132,42 @@ func (c *RetryableClient) DoWithRetry(ctx context.Context, req *http.Request) (*
 			}
 		}
 
+		logResponseBody(resp)
 		return resp, nil
 	}
 
 	return nil, lastErr
 }
 
+// logRequestBody logs the request body when HTTP body logging is enabled. It reads
+// from a fresh copy via GetBody so the request itself is left untouched.
+func logRequestBody(req *http.Request) {
+	if !logger.HTTPBodiesEnabled() || req.GetBody == nil {
+		return
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return
+	}
+	defer body.Close()
+	data, _ := io.ReadAll(io.LimitReader(body, logger.HTTPBodyLimit+1))
+	logger.HTTPBody("request", data)
+}
+
+// logResponseBody logs the head of the response body when HTTP body logging is
+// enabled, then restores it so callers can still read the full body.
+func logResponseBody(resp *http.Response) {
+	if !logger.HTTPBodiesEnabled() || resp.Body == nil {
+		return
+	}
+	head, _ := io.ReadAll(io.LimitReader(resp.Body, logger.HTTPBodyLimit+1))
+	logger.HTTPBody(fmt.Sprintf("response (%d)", resp.StatusCode), head)
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(head), resp.Body), resp.Body}
+}
+
 // DoJSONRequest executes a JSON request with retry logic and decodes the response
 func (c *RetryableClient) DoJSONRequest(ctx context.Context, req *http.Request, result interface{}) error {
 	resp, err := c.DoWithRetry(ctx, req)
@@ -103,7 +182,32 @@ func (c *RetryableClient) DoJSONRequest(ctx context.Context, req *http.Request,
 		return errors.NewHttpError(resp.StatusCode, string(body))
 	}
 
-	return json.NewDecoder(resp.Body).Decode(result)
+	// SSO proxies in front of JIRA answer intercepted requests with an HTML login page
+	body := bufio.NewReader(resp.Body)
+	if isHTMLResponse(resp.Header.Get("Content-Type"), body) {
+		return errors.NewHTMLResponseError(req.URL.Host)
+	}
+	return json.NewDecoder(body).Decode(result)
+}
+
+// isHTMLResponse reports whether a response is HTML rather than JSON, going by its
+// Content-Type or, failing that, a body starting with '<'
+func isHTMLResponse(contentType string, body *bufio.Reader) bool {
+	if strings.Contains(strings.ToLower(contentType), "html") {
+		return true
+	}
+	for {
+		b, err := body.Peek(1)
+		if err != nil {
+			return false
+		}
+		switch b[0] {
+		case ' ', '\t', '\r', '\n':
+			body.Discard(1)
+		default:
+			return b[0] == '<'
+		}
+	}
 }
 
 // shouldRetry determines if a status code indicates a retryable error
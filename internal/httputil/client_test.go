@@ -2,10 +2,14 @@ package httputil
 
 import (
 	"context"
+	stderrors "errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
+
+	"gci/internal/errors"
 )
 
 func TestRetryableClient_DoWithRetry_Success(t *testing.T) {
@@ -153,4 +157,83 @@ func TestRetryableClient_DoJSONRequest(t *testing.T) {
 	if result.Count != 42 {
 		t.Errorf("Expected count 42, got %d", result.Count)
 	}
-}
\ No newline at end of file
+}
+func TestRetryableClient_DoJSONRequest_HTML(t *testing.T) {
+	for name, contentType := range map[string]string{"content type": "text/html; charset=utf-8", "body": "application/json"} {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", contentType)
+				w.Write([]byte("\n  <!DOCTYPE html><html><body>Sign in</body></html>"))
+			}))
+			defer server.Close()
+
+			req, _ := http.NewRequest("GET", server.URL, nil)
+			var result map[string]any
+			err := NewDefaultClient().DoJSONRequest(context.Background(), req, &result)
+			var userErr *errors.UserError
+			if !stderrors.As(err, &userErr) || !strings.Contains(userErr.Message, "HTML") || userErr.Code != errors.ExitAuth {
+				t.Errorf("expected an HTML response UserError, got %v", err)
+			}
+		})
+	}
+}
+
+func TestRetryableClient_DoWithRetry_Headers(t *testing.T) {
+	var gotUA, gotKey string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUA = r.Header.Get("User-Agent")
+		gotKey = r.Header.Get("X-Gateway-Key")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	SetExtraHeaders(map[string]string{"X-Gateway-Key": "secret"})
+	defer SetExtraHeaders(nil)
+
+	client := NewRetryableClient(5*time.Second, 0)
+	req, err := http.NewRequest("GET", server.URL, nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	resp, err := client.DoWithRetry(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if gotUA != UserAgent() {
+		t.Errorf("Expected User-Agent %q, got %q", UserAgent(), gotUA)
+	}
+	if gotKey != "secret" {
+		t.Errorf("Expected extra header to be sent, got %q", gotKey)
+	}
+}
+
+func TestTimeoutOverride(t *testing.T) {
+	defer SetTimeout(0)
+	if got := Timeout(5 * time.Second); got != 5*time.Second {
+		t.Errorf("without --timeout the operation's timeout applies, got %v", got)
+	}
+	SetTimeout(90 * time.Second)
+	if got := Timeout(5 * time.Second); got != 90*time.Second {
+		t.Errorf("--timeout should raise short timeouts, got %v", got)
+	}
+	if c := NewRetryableClient(time.Second, 0); c.timeout != 90*time.Second || c.client.Timeout != 90*time.Second {
+		t.Errorf("clients should use --timeout, got %v", c.timeout)
+	}
+	SetTimeout(15 * time.Second)
+	if got := Timeout(DefaultTimeout); got != 15*time.Second {
+		t.Errorf("--timeout should shorten longer timeouts too, got %v", got)
+	}
+	SetTimeout(2 * time.Second)
+	if got := Timeout(DefaultTimeout); got != 10*time.Second {
+		t.Errorf("a 30s operation should keep its 10s minimum, got %v", got)
+	}
+	if got := Timeout(5 * time.Second); got != 2*time.Second {
+		t.Errorf("a quick check can go down to --timeout, got %v", got)
+	}
+	SetTimeout(time.Millisecond)
+	if got := Timeout(2 * time.Second); got != MinTimeout {
+		t.Errorf("--timeout should be clamped to MinTimeout, got %v", got)
+	}
+}
@@ -0,0 +1,53 @@
+package httputil
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"gci/internal/logger"
+	"gci/internal/version"
+)
+
+var (
+	headersMu    sync.RWMutex
+	extraHeaders map[string]string
+)
+
+// UserAgent identifies gci traffic to JIRA admins and gateways
+func UserAgent() string {
+	return "gci/" + version.GetShortVersion()
+}
+
+// SetExtraHeaders configures headers added to every request (e.g. an API-gateway key).
+// Values are never logged.
+func SetExtraHeaders(headers map[string]string) {
+	headersMu.Lock()
+	defer headersMu.Unlock()
+	extraHeaders = make(map[string]string, len(headers))
+	for name, value := range headers {
+		extraHeaders[name] = value
+	}
+
+	if len(headers) > 0 {
+		names := make([]string, 0, len(headers))
+		for name := range headers {
+			names = append(names, name+"=***")
+		}
+		sort.Strings(names)
+		logger.Config("extra request headers: %s", strings.Join(names, ", "))
+	}
+}
+
+// applyHeaders sets the User-Agent (unless the caller set one) and any extra headers
+func applyHeaders(req *http.Request) {
+	if req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", UserAgent())
+	}
+	headersMu.RLock()
+	defer headersMu.RUnlock()
+	for name, value := range extraHeaders {
+		req.Header.Set(name, value)
+	}
+}
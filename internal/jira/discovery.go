@@ -38,7 +38,7 @@ type DiscoveryCache struct {
 }
 
 func DiscoverBoards(jiraURL, email, apiToken string, projectKeys ...string) ([]Board, error) {
-	cacheFile := getCacheFilePath()
+	cacheFile := CacheFilePath()
 	
 	if cached, ok := loadFromCache(cacheFile); ok {
 		// Convert BoardWithActivity back to Board
@@ -67,7 +67,8 @@ func DiscoverBoards(jiraURL, email, apiToken string, projectKeys ...string) ([]B
 	return result, nil
 }
 
-func getCacheFilePath() string {
+// CacheFilePath returns where discovered boards are cached
+func CacheFilePath() string {
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
 		return ""
@@ -122,7 +123,7 @@ func FetchBoardsFromAPI(jiraURL, email, apiToken string) ([]Board, error) {
 }
 
 func fetchBoardsFromAPI(jiraURL, email, apiToken string, projectKeys ...string) ([]Board, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), httputil.Timeout(10*time.Second))
 	defer cancel()
 	
 	client := httputil.NewRetryableClient(10*time.Second, 2)
@@ -231,7 +232,7 @@ done:
 // fetchBoardActivity gets the count of recent issues for a board
 // Returns 0 if unable to fetch (graceful degradation)
 func fetchBoardActivity(boardID int, jiraURL, email, apiToken string) int {
-	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), httputil.Timeout(2*time.Second))
 	defer cancel()
 	
 	client := httputil.NewRetryableClient(2*time.Second, 1) // Quick timeout, minimal retries
@@ -260,10 +261,24 @@ func fetchBoardActivity(boardID int, jiraURL, email, apiToken string) int {
 	return issuesResp.Total
 }
 
+// Tiebreak preferences for boards with equal scores
+const (
+	BoardRankPreferOldest = "oldest" // lower board ID first (default)
+	BoardRankPreferNewest = "newest" // higher board ID first
+)
+
 func RankBoards(boards []Board, currentProjects []string) []Board {
+	return RankBoardsPreferring(boards, currentProjects, BoardRankPreferOldest)
+}
+
+// RankBoardsPreferring ranks boards like RankBoards, breaking score ties by board ID in
+// the direction given by prefer (BoardRankPreferOldest or BoardRankPreferNewest)
+func RankBoardsPreferring(boards []Board, currentProjects []string, prefer string) []Board {
+	preferNewest := prefer == BoardRankPreferNewest
+
 	// Load cached activity data if available
 	activityMap := make(map[int]int) // boardID -> activity count
-	cacheFile := getCacheFilePath()
+	cacheFile := CacheFilePath()
 	if cached, ok := loadFromCache(cacheFile); ok {
 		for _, bwa := range cached {
 			activityMap[bwa.Board.ID] = bwa.RecentActivity
@@ -320,8 +335,12 @@ func RankBoards(boards []Board, currentProjects []string) []Board {
 	// Sort by score (deterministic - uses board ID as tiebreaker for consistency)
 	for i := 0; i < len(scored)-1; i++ {
 		for j := i + 1; j < len(scored); j++ {
+			tieSwap := scored[i].board.ID > scored[j].board.ID
+			if preferNewest {
+				tieSwap = scored[i].board.ID < scored[j].board.ID
+			}
 			if scored[i].score < scored[j].score || 
-			   (scored[i].score == scored[j].score && scored[i].board.ID > scored[j].board.ID) {
+			   (scored[i].score == scored[j].score && tieSwap) {
 				scored[i], scored[j] = scored[j], scored[i]
 			}
 		}
@@ -98,7 +98,7 @@ func TestBoardWithActivity(t *testing.T) {
 }
 
 func TestGetCacheFilePath(t *testing.T) {
-	path := getCacheFilePath()
+	path := CacheFilePath()
 	if path == "" {
 		t.Skip("No home directory available")
 	}
@@ -106,4 +106,23 @@ func TestGetCacheFilePath(t *testing.T) {
 	if len(path) < 21 || path[len(path)-21:] != "gci_boards_cache.json" {
 		t.Errorf("Cache file path should end with gci_boards_cache.json, got %s", path)
 	}
-}
\ No newline at end of file
+}
+
+func TestRankBoardsPreferNewest(t *testing.T) {
+	boards := []Board{
+		{ID: 100, Name: "Board A", Type: "scrum", Location: struct{ ProjectKey string `json:"projectKey"` }{ProjectKey: "PROJ"}},
+		{ID: 50, Name: "Board B", Type: "scrum", Location: struct{ ProjectKey string `json:"projectKey"` }{ProjectKey: "PROJ"}},
+		{ID: 75, Name: "Board C", Type: "scrum", Location: struct{ ProjectKey string `json:"projectKey"` }{ProjectKey: "PROJ"}},
+		{ID: 200, Name: "Board D", Type: "kanban", Location: struct{ ProjectKey string `json:"projectKey"` }{ProjectKey: "PROJ"}},
+	}
+
+	ranked := RankBoardsPreferring(boards, []string{"PROJ"}, BoardRankPreferNewest)
+
+	// Score still wins: the kanban board ranks last despite the highest ID
+	expected := []int{100, 75, 50, 200}
+	for i, id := range expected {
+		if ranked[i].ID != id {
+			t.Errorf("Position %d: expected board %d, got %d", i, id, ranked[i].ID)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"gci/internal/httputil"
+)
+
+type Project struct {
+	ID   string `json:"id"`
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
+// FetchProject looks up a project by key so configured keys can be checked for typos
+// and permission problems. 404 and 403 get short, user-facing reasons.
+func FetchProject(jiraURL, email, apiToken, key string) (Project, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), httputil.Timeout(5*time.Second))
+	defer cancel()
+
+	client := httputil.NewRetryableClient(5*time.Second, 1)
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/rest/api/3/project/%s", jiraURL, key), nil)
+	if err != nil {
+		return Project{}, fmt.Errorf("failed to create request: %v", err)
+	}
+	req.SetBasicAuth(email, apiToken)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.DoWithRetry(ctx, req)
+	if err != nil {
+		return Project{}, fmt.Errorf("failed to fetch project %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return Project{}, fmt.Errorf("project %s does not exist or is not visible to you (HTTP 404)", key)
+	case http.StatusForbidden:
+		return Project{}, fmt.Errorf("no permission to browse project %s (HTTP 403)", key)
+	default:
+		return Project{}, fmt.Errorf("unexpected HTTP %d fetching project %s", resp.StatusCode, key)
+	}
+
+	var project Project
+	if err := json.NewDecoder(resp.Body).Decode(&project); err != nil {
+		return Project{}, fmt.Errorf("failed to decode project %s: %w", key, err)
+	}
+	return project, nil
+}
+
+type projectSearchPage struct {
+	Values []Project `json:"values"`
+	IsLast bool      `json:"isLast"`
+}
+
+// SearchProjects lists every project the user can browse, following pagination
+func SearchProjects(jiraURL, email, apiToken string) ([]Project, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), httputil.Timeout(15*time.Second))
+	defer cancel()
+
+	client := httputil.NewRetryableClient(5*time.Second, 1)
+	var projects []Project
+	for startAt := 0; ; {
+		url := fmt.Sprintf("%s/rest/api/3/project/search?startAt=%d&maxResults=50&orderBy=key", jiraURL, startAt)
+		req, err := http.NewRequest("GET", url, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %v", err)
+		}
+		req.SetBasicAuth(email, apiToken)
+		req.Header.Set("Accept", "application/json")
+
+		resp, err := client.DoWithRetry(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list projects: %w", err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("unexpected HTTP %d listing projects", resp.StatusCode)
+		}
+		var page projectSearchPage
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode projects: %w", err)
+		}
+		projects = append(projects, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			return projects, nil
+		}
+		startAt += len(page.Values)
+	}
+}
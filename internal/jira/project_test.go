@@ -0,0 +1,73 @@
+package jira
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestFetchProject(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/3/project/INF":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id": "10000", "key": "INF", "name": "Infrastructure"}`))
+		case "/rest/api/3/project/SECRET":
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	project, err := FetchProject(server.URL, "test@example.com", "test-token", "INF")
+	if err != nil {
+		t.Fatalf("FetchProject failed: %v", err)
+	}
+	if project.Name != "Infrastructure" {
+		t.Errorf("Expected project name 'Infrastructure', got %q", project.Name)
+	}
+
+	tests := []struct {
+		key      string
+		expected string
+	}{
+		{"TYPO", "HTTP 404"},
+		{"SECRET", "HTTP 403"},
+	}
+	for _, tt := range tests {
+		_, err := FetchProject(server.URL, "test@example.com", "test-token", tt.key)
+		if err == nil || !strings.Contains(err.Error(), tt.expected) {
+			t.Errorf("FetchProject(%s): expected error containing %q, got %v", tt.key, tt.expected, err)
+		}
+	}
+}
+
+func TestSearchProjects(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/project/search" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("startAt") == "0" {
+			w.Write([]byte(`{"values": [{"key": "API", "name": "API"}, {"key": "INF", "name": "Infrastructure"}], "isLast": false}`))
+			return
+		}
+		w.Write([]byte(`{"values": [{"key": "WEB", "name": "Website"}], "isLast": true}`))
+	}))
+	defer server.Close()
+
+	projects, err := SearchProjects(server.URL, "test@example.com", "test-token")
+	if err != nil {
+		t.Fatalf("SearchProjects failed: %v", err)
+	}
+	if len(projects) != 3 || projects[2].Key != "WEB" {
+		t.Errorf("expected projects from both pages, got %+v", projects)
+	}
+
+	if _, err := SearchProjects(server.URL+"/missing", "test@example.com", "test-token"); err == nil || !strings.Contains(err.Error(), "HTTP 404") {
+		t.Errorf("expected an HTTP 404 error, got %v", err)
+	}
+}
@@ -0,0 +1,95 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"gci/internal/httputil"
+)
+
+// Stable statusCategory keys. Unlike the display names ("To Do", "In Progress",
+// "Done"), these are not translated on localized JIRA instances.
+const (
+	StatusCategoryNew           = "new"
+	StatusCategoryIndeterminate = "indeterminate"
+	StatusCategoryDone          = "done"
+)
+
+// DefaultStatusCategoryNames maps each stable key to the English display name
+// used by JIRA instances that have not been localized.
+var DefaultStatusCategoryNames = map[string]string{
+	StatusCategoryNew:           "To Do",
+	StatusCategoryIndeterminate: "In Progress",
+	StatusCategoryDone:          "Done",
+}
+
+type StatusCategory struct {
+	ID        int    `json:"id"`
+	Key       string `json:"key"`
+	Name      string `json:"name"`
+	ColorName string `json:"colorName"`
+}
+
+// FetchStatusCategories returns the instance's statusCategory display names keyed
+// by their stable key (new/indeterminate/done).
+func FetchStatusCategories(jiraURL, email, apiToken string) (map[string]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), httputil.Timeout(5*time.Second))
+	defer cancel()
+
+	client := httputil.NewRetryableClient(5*time.Second, 1)
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/rest/api/3/statuscategory", jiraURL), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %v", err)
+	}
+	req.SetBasicAuth(email, apiToken)
+	req.Header.Set("Accept", "application/json")
+
+	var categories []StatusCategory
+	if err := client.DoJSONRequest(ctx, req, &categories); err != nil {
+		return nil, fmt.Errorf("failed to fetch status categories: %w", err)
+	}
+
+	names := make(map[string]string, len(categories))
+	for _, c := range categories {
+		if c.Key == "" || c.Name == "" {
+			continue
+		}
+		names[c.Key] = c.Name
+	}
+	return names, nil
+}
+
+type Status struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// FetchStatusNames returns the names of every workflow status on the instance, so
+// configured status lists can be checked before they are used in JQL.
+func FetchStatusNames(jiraURL, email, apiToken string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), httputil.Timeout(5*time.Second))
+	defer cancel()
+
+	client := httputil.NewRetryableClient(5*time.Second, 1)
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/rest/api/3/status", jiraURL), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %v", err)
+	}
+	req.SetBasicAuth(email, apiToken)
+	req.Header.Set("Accept", "application/json")
+
+	var statuses []Status
+	if err := client.DoJSONRequest(ctx, req, &statuses); err != nil {
+		return nil, fmt.Errorf("failed to fetch statuses: %w", err)
+	}
+
+	names := make([]string, 0, len(statuses))
+	for _, s := range statuses {
+		if s.Name != "" {
+			names = append(names, s.Name)
+		}
+	}
+	return names, nil
+}
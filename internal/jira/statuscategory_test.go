@@ -0,0 +1,69 @@
+package jira
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFetchStatusCategories(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/statuscategory" {
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[
+			{"id": 1, "key": "undefined", "name": "Keine Kategorie", "colorName": "medium-gray"},
+			{"id": 2, "key": "new", "name": "Aufgaben", "colorName": "blue-gray"},
+			{"id": 4, "key": "indeterminate", "name": "In Arbeit", "colorName": "yellow"},
+			{"id": 3, "key": "done", "name": "Fertig", "colorName": "green"}
+		]`))
+	}))
+	defer server.Close()
+
+	names, err := FetchStatusCategories(server.URL, "test@example.com", "test-token")
+	if err != nil {
+		t.Fatalf("FetchStatusCategories failed: %v", err)
+	}
+
+	expected := map[string]string{
+		StatusCategoryNew:           "Aufgaben",
+		StatusCategoryIndeterminate: "In Arbeit",
+		StatusCategoryDone:          "Fertig",
+	}
+	for key, want := range expected {
+		if names[key] != want {
+			t.Errorf("names[%q] = %q, want %q", key, names[key], want)
+		}
+	}
+}
+
+func TestFetchStatusCategories_HTTPError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	if _, err := FetchStatusCategories(server.URL, "test@example.com", "bad-token"); err == nil {
+		t.Error("Expected error for 401 response")
+	}
+}
+
+func TestFetchStatusNames(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/status" {
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"id": "1", "name": "Open"}, {"id": "3", "name": "In Progress"}, {"id": "9", "name": ""}]`))
+	}))
+	defer server.Close()
+
+	names, err := FetchStatusNames(server.URL, "test@example.com", "test-token")
+	if err != nil {
+		t.Fatalf("FetchStatusNames failed: %v", err)
+	}
+	if len(names) != 2 || names[0] != "Open" || names[1] != "In Progress" {
+		t.Errorf("Unexpected names: %v", names)
+	}
+}
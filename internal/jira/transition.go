@@ -0,0 +1,56 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"gci/internal/httputil"
+)
+
+type Transition struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	To   struct {
+		Name string `json:"name"`
+	} `json:"to"`
+}
+
+// FetchTransitions returns the workflow transitions currently available for an issue
+func FetchTransitions(jiraURL, email, apiToken, key string) ([]Transition, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), httputil.Timeout(httputil.DefaultTimeout))
+	defer cancel()
+
+	client := httputil.NewDefaultClient()
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/rest/api/3/issue/%s/transitions", jiraURL, key), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %v", err)
+	}
+	req.SetBasicAuth(email, apiToken)
+	req.Header.Set("Accept", "application/json")
+
+	var resp struct {
+		Transitions []Transition `json:"transitions"`
+	}
+	if err := client.DoJSONRequest(ctx, req, &resp); err != nil {
+		return nil, fmt.Errorf("failed to fetch transitions for %s: %w", key, err)
+	}
+	return resp.Transitions, nil
+}
+
+// MatchTransition finds the transition named by want, which may be a transition id,
+// a transition name, or the name of the status it leads to (case-insensitive).
+func MatchTransition(transitions []Transition, want string) (Transition, bool) {
+	for _, t := range transitions {
+		if t.ID == want {
+			return t, true
+		}
+	}
+	for _, t := range transitions {
+		if strings.EqualFold(t.Name, want) || strings.EqualFold(t.To.Name, want) {
+			return t, true
+		}
+	}
+	return Transition{}, false
+}
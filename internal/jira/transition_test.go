@@ -0,0 +1,35 @@
+package jira
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFetchTransitionsAndMatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/issue/TEST-1/transitions" {
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"transitions": [
+			{"id": "11", "name": "Start Progress", "to": {"name": "In Progress"}},
+			{"id": "31", "name": "Done", "to": {"name": "Done"}}
+		]}`))
+	}))
+	defer server.Close()
+
+	transitions, err := FetchTransitions(server.URL, "test@example.com", "test-token", "TEST-1")
+	if err != nil {
+		t.Fatalf("FetchTransitions failed: %v", err)
+	}
+
+	for _, want := range []string{"11", "start progress", "In Progress"} {
+		if got, ok := MatchTransition(transitions, want); !ok || got.ID != "11" {
+			t.Errorf("MatchTransition(%q) = %+v, %v", want, got, ok)
+		}
+	}
+	if _, ok := MatchTransition(transitions, "Blocked"); ok {
+		t.Error("Expected no match for an unavailable transition")
+	}
+}
@@ -0,0 +1,75 @@
+package jira
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// JIRA's default time-tracking units: a day is 8 hours and a week is 5 days
+var worklogUnitSeconds = map[string]float64{
+	"w": 5 * 8 * 3600,
+	"d": 8 * 3600,
+	"h": 3600,
+	"m": 60,
+}
+
+var worklogPartRe = regexp.MustCompile(`^(\d+(?:\.\d+)?)([wdhm])$`)
+
+// ParseWorkDuration parses a JIRA-style duration such as "1h 30m", "2d" or "1.5h"
+// into seconds. Each unit may appear once, largest first.
+func ParseWorkDuration(s string) (int, error) {
+	fields := strings.Fields(strings.ToLower(s))
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("empty duration")
+	}
+	order := "wdhm"
+	last := -1
+	var total float64
+	for _, f := range fields {
+		m := worklogPartRe.FindStringSubmatch(f)
+		if m == nil {
+			return 0, fmt.Errorf("invalid duration %q (use e.g. 1w 2d 3h 30m)", s)
+		}
+		pos := strings.Index(order, m[2])
+		if pos <= last {
+			return 0, fmt.Errorf("invalid duration %q: units must be in w d h m order without repeats", s)
+		}
+		last = pos
+		n, _ := strconv.ParseFloat(m[1], 64)
+		total += n * worklogUnitSeconds[m[2]]
+	}
+	return int(total), nil
+}
+
+// Worklog is the request body for adding a worklog entry
+type Worklog struct {
+	TimeSpentSeconds int `json:"timeSpentSeconds"`
+}
+
+// NewWorklog validates timeSpent and remaining and returns the worklog body plus the
+// path (relative to the issue) to POST it to. An empty remaining lets JIRA reduce the
+// estimate automatically; otherwise the remaining estimate is set to it.
+func NewWorklog(timeSpent, remaining string) (Worklog, string, error) {
+	seconds, err := ParseWorkDuration(timeSpent)
+	if err != nil {
+		return Worklog{}, "", err
+	}
+	if seconds < 60 {
+		return Worklog{}, "", fmt.Errorf("time spent must be at least 1m")
+	}
+
+	path := "/worklog"
+	if remaining = strings.TrimSpace(remaining); remaining != "" {
+		if _, err := ParseWorkDuration(remaining); err != nil {
+			return Worklog{}, "", fmt.Errorf("remaining estimate: %w", err)
+		}
+		q := url.Values{}
+		q.Set("adjustEstimate", "new")
+		q.Set("newEstimate", remaining)
+		path += "?" + q.Encode()
+	}
+	return Worklog{TimeSpentSeconds: seconds}, path, nil
+}
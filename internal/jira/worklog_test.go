@@ -0,0 +1,51 @@
+package jira
+
+import "testing"
+
+func TestParseWorkDuration(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    int
+		wantErr bool
+	}{
+		{"1h 30m", 5400, false},
+		{"2d", 2 * 8 * 3600, false},
+		{"1w", 5 * 8 * 3600, false},
+		{"1.5h", 5400, false},
+		{"0m", 0, false},
+		{"", 0, true},
+		{"90", 0, true},
+		{"30m 1h", 0, true},
+		{"1h 1h", 0, true},
+		{"1x", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseWorkDuration(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseWorkDuration(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseWorkDuration(%q) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestNewWorklog(t *testing.T) {
+	w, path, err := NewWorklog("1h", "")
+	if err != nil || w.TimeSpentSeconds != 3600 || path != "/worklog" {
+		t.Errorf("NewWorklog(1h) = %+v, %q, %v", w, path, err)
+	}
+
+	_, path, err = NewWorklog("30m", "2h 30m")
+	if err != nil || path != "/worklog?adjustEstimate=new&newEstimate=2h+30m" {
+		t.Errorf("NewWorklog with remaining = %q, %v", path, err)
+	}
+
+	if _, _, err := NewWorklog("0m", ""); err == nil {
+		t.Error("Expected error for zero time spent")
+	}
+	if _, _, err := NewWorklog("1h", "soon"); err == nil {
+		t.Error("Expected error for invalid remaining estimate")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"time"
 )
@@ -78,14 +79,21 @@ func SetVerbose(verbose bool) {
 	}
 }
 
-// getDebugLogFile returns a file handle for debug logging
-func getDebugLogFile() *os.File {
+// DebugLogPath returns where verbose logging is written
+func DebugLogPath() string {
 	home, err := os.UserHomeDir()
 	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".config", "gci_debug.log")
+}
+
+// getDebugLogFile returns a file handle for debug logging
+func getDebugLogFile() *os.File {
+	logPath := DebugLogPath()
+	if logPath == "" {
 		return nil
 	}
-	
-	logPath := filepath.Join(home, ".config", "gci_debug.log")
 	if err := os.MkdirAll(filepath.Dir(logPath), 0755); err != nil {
 		return nil
 	}
@@ -118,6 +126,33 @@ func (l *Logger) log(level LogLevel, format string, args ...interface{}) {
 	l.output.Write([]byte(logLine))
 }
 
+// logMasked logs a message after masking sensitive values in place, instead of
+// redacting the whole line. Used for payloads where keys like "key" are expected.
+func (l *Logger) logMasked(level LogLevel, message string) {
+	if level < l.level {
+		return
+	}
+
+	timestamp := time.Now().Format("2006-01-02T15:04:05")
+	logLine := fmt.Sprintf("%s %s [%s] %s\n", timestamp, level.String(), l.prefix, maskSensitive(message))
+	l.output.Write([]byte(logLine))
+}
+
+var (
+	// "apiToken": "abc" / "password":"abc" style JSON fields
+	sensitiveJSONField = regexp.MustCompile(`(?i)("[a-z0-9_]*(?:token|password|secret|apikey|api_key|credential|authorization)[a-z0-9_]*"\s*:\s*)"[^"]*"`)
+	// Basic/Bearer credentials embedded in text
+	sensitiveAuthValue = regexp.MustCompile(`(?i)\b(basic|bearer)\s+[a-z0-9+/=._~-]+`)
+)
+
+// maskSensitive replaces sensitive values in a message with [REDACTED] while keeping
+// the surrounding structure readable
+func maskSensitive(message string) string {
+	message = sensitiveJSONField.ReplaceAllString(message, `$1"[REDACTED]"`)
+	message = sensitiveAuthValue.ReplaceAllString(message, "$1 [REDACTED]")
+	return message
+}
+
 // containsSensitive checks if a message contains sensitive information
 func containsSensitive(message string) bool {
 	lower := strings.ToLower(message)
@@ -161,6 +196,28 @@ func HTTP(method, url string) {
 	Debug("HTTP %s %s", method, url)
 }
 
+// HTTPBodyLimit caps how many bytes of a request/response body are logged
+const HTTPBodyLimit = 2048
+
+// HTTPBodiesEnabled reports whether HTTP bodies should be logged. Requires both
+// --verbose and GCI_LOG_HTTP_BODIES=1, since bodies can be large and sensitive.
+func HTTPBodiesEnabled() bool {
+	return defaultLogger.level <= LevelDebug && os.Getenv("GCI_LOG_HTTP_BODIES") == "1"
+}
+
+// HTTPBody logs a masked request or response body (debug level, opt-in). Callers
+// pass at most HTTPBodyLimit+1 bytes; anything past the limit is marked truncated.
+func HTTPBody(direction string, body []byte) {
+	if !HTTPBodiesEnabled() || len(body) == 0 {
+		return
+	}
+	text := string(body)
+	if len(text) > HTTPBodyLimit {
+		text = text[:HTTPBodyLimit] + " ... [truncated]"
+	}
+	defaultLogger.logMasked(LevelDebug, fmt.Sprintf("HTTP %s body: %s", direction, text))
+}
+
 // HTTPResponse logs HTTP response information (debug level)
 func HTTPResponse(status int, duration time.Duration) {
 	Debug("HTTP response: %d (%v)", status, duration)
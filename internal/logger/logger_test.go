@@ -0,0 +1,78 @@
+package logger
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestMaskSensitive(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "JSON token field",
+			input:    `{"key":"PROJ-1","apiToken":"abc123"}`,
+			expected: `{"key":"PROJ-1","apiToken":"[REDACTED]"}`,
+		},
+		{
+			name:     "JSON password field with spacing",
+			input:    `{"password" : "hunter2"}`,
+			expected: `{"password" : "[REDACTED]"}`,
+		},
+		{
+			name:     "basic auth header",
+			input:    "Authorization: Basic dXNlcjpwYXNz",
+			expected: "Authorization: Basic [REDACTED]",
+		},
+		{
+			name:     "plain JIRA payload untouched",
+			input:    `{"fields":{"summary":"Fix login","project":{"key":"PROJ"}}}`,
+			expected: `{"fields":{"summary":"Fix login","project":{"key":"PROJ"}}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := maskSensitive(tt.input); got != tt.expected {
+				t.Errorf("maskSensitive(%q) = %q, want %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestHTTPBody_RequiresVerboseAndOptIn(t *testing.T) {
+	var buf bytes.Buffer
+	original := defaultLogger
+	defer func() { defaultLogger = original }()
+
+	origEnv := os.Getenv("GCI_LOG_HTTP_BODIES")
+	defer os.Setenv("GCI_LOG_HTTP_BODIES", origEnv)
+
+	// Verbose but not opted in
+	defaultLogger = New(LevelDebug, &buf, "test")
+	os.Setenv("GCI_LOG_HTTP_BODIES", "")
+	HTTPBody("request", []byte(`{"summary":"x"}`))
+	if buf.Len() != 0 {
+		t.Errorf("Expected no output without GCI_LOG_HTTP_BODIES, got: %s", buf.String())
+	}
+
+	// Opted in but not verbose
+	defaultLogger = New(LevelInfo, &buf, "test")
+	os.Setenv("GCI_LOG_HTTP_BODIES", "1")
+	HTTPBody("request", []byte(`{"summary":"x"}`))
+	if buf.Len() != 0 {
+		t.Errorf("Expected no output without verbose, got: %s", buf.String())
+	}
+
+	// Both: body is logged, truncated past the limit
+	defaultLogger = New(LevelDebug, &buf, "test")
+	HTTPBody("response (400)", []byte(strings.Repeat("a", HTTPBodyLimit+1)))
+	out := buf.String()
+	if !strings.Contains(out, "HTTP response (400) body:") || !strings.Contains(out, "[truncated]") {
+		t.Errorf("Expected truncated body log, got: %s", out)
+	}
+}
@@ -0,0 +1,123 @@
+package usercfg
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+)
+
+// backupTimeFormat names backups so they sort oldest to newest
+const backupTimeFormat = "20060102-150405"
+
+// BackupDir returns where config backups are kept: a backups directory next to config.toml
+func BackupDir() string {
+	configPath := Path()
+	if configPath == "" {
+		return ""
+	}
+	return filepath.Join(filepath.Dir(configPath), "backups")
+}
+
+// activePath returns the config file Load reads: the XDG path, else the legacy one
+func activePath() (string, error) {
+	for _, path := range []string{Path(), LegacyPath()} {
+		if path == "" {
+			return "", fmt.Errorf("unable to determine home directory")
+		}
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+	return "", ErrNotConfigured
+}
+
+// Backup copies the config file to a timestamped file in BackupDir and returns its path
+func Backup() (string, error) {
+	configPath, err := activePath()
+	if err != nil {
+		return "", err
+	}
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read config: %v", err)
+	}
+
+	dir := BackupDir()
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create backup directory: %v", err)
+	}
+	stamp := time.Now().Format(backupTimeFormat)
+	path := filepath.Join(dir, fmt.Sprintf("config-%s.toml", stamp))
+	for n := 2; ; n++ {
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			break
+		}
+		path = filepath.Join(dir, fmt.Sprintf("config-%s-%d.toml", stamp, n))
+	}
+	if err := writeFileAtomic(path, data); err != nil {
+		return "", err
+	}
+	// Backups may hold a 1Password path or email; keep them as private as the config
+	if info, err := os.Stat(configPath); err == nil {
+		_ = os.Chmod(path, info.Mode().Perm())
+	}
+	return path, nil
+}
+
+// ListBackups returns the backup files in BackupDir, newest first
+func ListBackups() ([]string, error) {
+	entries, err := os.ReadDir(BackupDir())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var backups []string
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasPrefix(e.Name(), "config-") && strings.HasSuffix(e.Name(), ".toml") {
+			backups = append(backups, filepath.Join(BackupDir(), e.Name()))
+		}
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(backups)))
+	return backups, nil
+}
+
+// Restore replaces the config file with the backup at path after checking that it
+// parses. The current config, if any, is backed up first so a restore can be undone;
+// that backup's path is returned.
+func Restore(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read backup: %v", err)
+	}
+	var config Config
+	if _, err := toml.Decode(string(data), &config); err != nil {
+		return "", fmt.Errorf("%s is not a valid config: %v", path, err)
+	}
+
+	previous, err := Backup()
+	if err != nil && err != ErrNotConfigured {
+		return "", fmt.Errorf("failed to back up current config: %v", err)
+	}
+
+	configPath := Path()
+	if err := os.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
+		return "", fmt.Errorf("failed to create config directory: %v", err)
+	}
+	unlock, err := lockConfig(configPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to lock config: %v", err)
+	}
+	defer unlock()
+
+	if err := writeFileAtomic(configPath, data); err != nil {
+		return "", err
+	}
+	return previous, nil
+}
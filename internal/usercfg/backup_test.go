@@ -0,0 +1,57 @@
+package usercfg
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBackupAndRestore(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	if _, err := Backup(); err != ErrNotConfigured {
+		t.Errorf("Backup() without a config = %v, want ErrNotConfigured", err)
+	}
+
+	if err := Save(Config{Projects: []string{"ORIG"}, JiraURL: "https://test.example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	first, err := Backup()
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := Backup()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first == second || filepath.Dir(first) != BackupDir() {
+		t.Errorf("backups should get distinct names in BackupDir: %s, %s", first, second)
+	}
+	if backups, err := ListBackups(); err != nil || len(backups) != 2 {
+		t.Errorf("ListBackups() = %v, %v; want both backups", backups, err)
+	}
+
+	if err := Save(Config{Projects: []string{"BROKEN"}, JiraURL: "https://test.example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	previous, err := Restore(first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded, _ := Load(); len(loaded.Projects) != 1 || loaded.Projects[0] != "ORIG" {
+		t.Errorf("restored config has projects %v, want [ORIG]", loaded.Projects)
+	}
+	if data, _ := os.ReadFile(previous); !strings.Contains(string(data), "BROKEN") {
+		t.Errorf("the replaced config should be backed up to %s", previous)
+	}
+
+	bad := filepath.Join(t.TempDir(), "bad.toml")
+	os.WriteFile(bad, []byte("projects = [unterminated"), 0644)
+	if _, err := Restore(bad); err == nil {
+		t.Error("a backup that doesn't parse should be rejected")
+	}
+	if loaded, _ := Load(); loaded.Projects[0] != "ORIG" {
+		t.Error("a rejected restore should leave the config alone")
+	}
+}
@@ -1,6 +1,7 @@
 package usercfg
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -37,13 +38,44 @@ type Config struct {
 	SchemaVersion     int               `toml:"schema_version,omitempty"`
 	Projects          []string          `toml:"projects"`
 	DefaultScope      string            `toml:"default_scope"`
+	ProjectScopes     map[string]string `toml:"project_scopes,omitempty"` // project -> scope used when only that project is queried
+	DefaultProject    string            `toml:"default_project,omitempty"` // project used when --project is not given
 	JiraURL           string            `toml:"jira_url"`
 	Boards            map[string]int    `toml:"boards"`
+	BoardRankPrefer   string            `toml:"board_rank_prefer,omitempty"` // oldest|newest: tiebreak for setup board suggestions
 	UIPrefs           UIPreferences     `toml:"ui_prefs,omitempty"`
 	EnableClaude      *bool             `toml:"enable_claude"`
 	EnableWorktrees   *bool             `toml:"enable_worktrees"`
+	WorktreeBaseDir   string            `toml:"worktree_base_dir,omitempty"` // where worktrees are created; default: the repo's parent dir
 	OPJiraTokenPath   string            `toml:"op_jira_token_path,omitempty"`
+	ProjectTokenPaths map[string]string `toml:"project_token_paths,omitempty"` // project -> 1Password token path
+	JiraEmail         string            `toml:"jira_email,omitempty"` // auth email used as-is instead of git user.email + email_domain_map
 	EmailDomainMap    map[string]string `toml:"email_domain_map,omitempty"`
+	StatusCategories  map[string]string `toml:"status_categories,omitempty"` // statusCategory key (new/indeterminate/done) -> localized name
+	DefaultComponents []string          `toml:"default_components,omitempty"` // components for gci create
+	DefaultIssueType  string            `toml:"default_issue_type,omitempty"` // issue type for gci create and board quick create
+	PickerStatuses    []string          `toml:"picker_statuses,omitempty"`    // statuses listed by the root issue picker; default: not Done
+	OnDirtyTree       string            `toml:"on_dirty_tree,omitempty"`      // prompt|stash|abort|ignore
+	SeparateBacklog   string            `toml:"separate_backlog,omitempty"`    // off|todo-only|all: where backlog issues are grouped below active ones
+	FilterKeyWeight     float64         `toml:"filter_key_weight,omitempty"`     // board filter: multiplier for issue key matches; default 1
+	FilterSummaryWeight float64         `toml:"filter_summary_weight,omitempty"` // board filter: multiplier for summary matches; default 1
+	OnStartTransition string            `toml:"on_start_transition,omitempty"` // transition (id, name, or target status) applied when starting an issue from the board
+	ExtraHeaders      map[string]string `toml:"extra_headers,omitempty"`      // headers added to every JIRA request
+	UpdateCheckTimeout string           `toml:"update_check_timeout,omitempty"` // how long update checks wait for GitHub, e.g. "10s"
+	ShowEpics         bool              `toml:"show_epics,omitempty"`      // board rows show each issue's epic
+	EpicLinkField     string            `toml:"epic_link_field,omitempty"` // classic Epic Link custom field id, e.g. customfield_10014
+	FlaggedField      string            `toml:"flagged_field,omitempty"`   // Flagged (impediment) custom field id, e.g. customfield_10021
+	RefreshOnFocus    bool              `toml:"refresh_on_focus,omitempty"` // board reloads when its terminal regains focus
+	DiffCharLimit     int               `toml:"diff_char_limit,omitempty"`  // characters of diff gci create sends Claude; default 8000
+	UnhideOnRefresh   bool              `toml:"unhide_on_refresh,omitempty"` // r (or a focus refresh) shows issues hidden with x again
+	IssueTypeMarkers  map[string]string `toml:"issue_type_markers,omitempty"` // issue type -> marker before the key on the board, over the defaults
+	AssigneeDisplay   string            `toml:"assignee_display,omitempty"`   // name|badges: board rows show @firstname (default) or colored initials
+	StatusColumnOverrides map[string]string `toml:"status_column_overrides,omitempty"` // status name -> board column ("To Do", "In Progress", "Done", "Other")
+	KeepOpen          bool              `toml:"keep_open,omitempty"`          // gci returns to the issue picker after creating a branch
+	BoardLoadTimeoutSeconds int         `toml:"board_load_timeout_seconds,omitempty"` // how long a board load waits before showing what it has; default 30 (20 for background scopes)
+	JQLPresets        map[string]string `toml:"jql_presets,omitempty"`        // preset name -> JQL; p on the board cycles the columns through them
+	BranchStripProject bool             `toml:"branch_strip_project,omitempty"` // branch names start with the issue number only (42_summary)
+	BranchKeyCase     string            `toml:"branch_key_case,omitempty"`      // upper (default) or lower: case of the key in branch names
 }
 
 type UIPreferences struct {
@@ -51,8 +83,11 @@ type UIPreferences struct {
 	LastFilter      string `toml:"last_filter,omitempty"`
 	ColumnWidths    []int  `toml:"column_widths,omitempty"`
 	LastSelectedCol int    `toml:"last_selected_col,omitempty"`
+	ColumnOrder     []string `toml:"column_order,omitempty"` // column titles in display order
 	FuzzySearch     bool   `toml:"fuzzy_search,omitempty"`
 	ShowExtraFields bool   `toml:"show_extra_fields,omitempty"`
+	RowLayout       string `toml:"row_layout,omitempty"` // compact|normal|detailed; unset follows show_extra_fields
+	FocusMode       bool   `toml:"focus_mode,omitempty"` // board hides Done and empty columns
 }
 
 const CurrentSchemaVersion = 1
@@ -125,16 +160,57 @@ func Save(config Config) error {
 		return fmt.Errorf("failed to create config directory: %v", err)
 	}
 
-	file, err := os.Create(configPath)
+	unlock, err := lockConfig(configPath)
 	if err != nil {
-		return fmt.Errorf("failed to create config file: %v", err)
+		return fmt.Errorf("failed to lock config: %v", err)
 	}
-	defer file.Close()
+	defer unlock()
+
+	return writeConfigFile(configPath, config)
+}
 
-	encoder := toml.NewEncoder(file)
-	if err := encoder.Encode(config); err != nil {
+// writeConfigFile encodes v as TOML and writes it over path with writeFileAtomic
+func writeConfigFile(path string, v interface{}) error {
+	var buf bytes.Buffer
+	if err := toml.NewEncoder(&buf).Encode(v); err != nil {
 		return fmt.Errorf("failed to encode config: %v", err)
 	}
+	return writeFileAtomic(path, buf.Bytes())
+}
+
+// writeFileAtomic writes data to a temp file in the same directory and renames it over
+// path, so an interrupted or failed write never leaves a truncated config. The
+// existing file's permissions are kept.
+func writeFileAtomic(path string, data []byte) error {
+	perm := os.FileMode(0644)
+	if info, err := os.Stat(path); err == nil {
+		perm = info.Mode().Perm()
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create config file: %v", err)
+	}
+	tmpPath := tmp.Name()
+	defer os.Remove(tmpPath) // no-op once renamed
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write config file: %v", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write config file: %v", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write config file: %v", err)
+	}
+	if err := os.Chmod(tmpPath, perm); err != nil {
+		return fmt.Errorf("failed to set config permissions: %v", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("failed to replace config file: %v", err)
+	}
 
 	return nil
 }
@@ -146,7 +222,8 @@ func GetRuntimeConfig() Config {
 		config = getDefaults()
 	}
 
-	// Apply environment variable overlays
+	// The repository's .gci.toml overrides the user config; env vars override both
+	config = applyRepoOverlay(config)
 	return applyEnvOverlays(config)
 }
 
@@ -159,6 +236,11 @@ func mergeWithDefaults(config Config) Config {
 		config.DefaultScope = "assigned_or_reported"
 	}
 
+	// OnDirtyTree defaults to prompting before stashing
+	if config.OnDirtyTree == "" {
+		config.OnDirtyTree = "prompt"
+	}
+
 	// EnableWorktrees defaults to true when not explicitly set
 	if config.EnableWorktrees == nil {
 		t := true
@@ -171,6 +253,9 @@ func mergeWithDefaults(config Config) Config {
 		config.EnableClaude = &f
 	}
 
+	// Drop UI preferences no board could use; the board rechecks column-dependent ones
+	config.UIPrefs = config.UIPrefs.Sanitize(0)
+
 	// Projects, JiraURL, Boards: left empty if not in config file.
 	// The caller must handle empty values (e.g. prompt for gci setup).
 
@@ -212,6 +297,11 @@ func applyEnvOverlays(config Config) Config {
 		config.DefaultScope = envScope
 	}
 
+	// GCI_DEFAULT_PROJECT: override default project
+	if v := os.Getenv("GCI_DEFAULT_PROJECT"); v != "" {
+		config.DefaultProject = strings.TrimSpace(v)
+	}
+
 	// GCI_JIRA_URL: override JIRA URL
 	if envJiraURL := os.Getenv("GCI_JIRA_URL"); envJiraURL != "" {
 		config.JiraURL = envJiraURL
@@ -248,14 +338,15 @@ func migrateConfig(config Config) Config {
 }
 
 // MigrateAndSave loads the config, applies migrations, and saves it back to disk
-// This is used by the `gci config migrate` command
-func MigrateAndSave() error {
+// This is used by the `gci config migrate` command. It returns the path of the backup
+// taken before the migrated config was written.
+func MigrateAndSave() (string, error) {
 	// Load the raw config without going through the full Load() process
 	configPath := Path()
 	legacyPath := LegacyPath()
 
 	if configPath == "" || legacyPath == "" {
-		return fmt.Errorf("unable to determine home directory")
+		return "", fmt.Errorf("unable to determine home directory")
 	}
 
 	var actualPath string
@@ -266,49 +357,91 @@ func MigrateAndSave() error {
 	} else if _, err := os.Stat(legacyPath); err == nil {
 		actualPath = legacyPath
 	} else {
-		return fmt.Errorf("no config file found to migrate")
+		return "", fmt.Errorf("no config file found to migrate")
 	}
 
 	var rawConfig Config
 	if _, err := toml.DecodeFile(actualPath, &rawConfig); err != nil {
-		return fmt.Errorf("failed to decode config file: %v", err)
+		return "", fmt.Errorf("failed to decode config file: %v", err)
 	}
 
 	originalVersion := rawConfig.SchemaVersion
 	if originalVersion == CurrentSchemaVersion {
-		return fmt.Errorf("config is already at current schema version %d", CurrentSchemaVersion)
+		return "", fmt.Errorf("config is already at current schema version %d", CurrentSchemaVersion)
 	}
 
 	// Now apply the full Load() process which includes migration and merging
 	config, err := Load()
 	if err != nil {
-		return fmt.Errorf("failed to load config for migration: %v", err)
+		return "", fmt.Errorf("failed to load config for migration: %v", err)
+	}
+
+	// Keep a copy of the file as it was in case the migration goes wrong
+	backupPath, err := Backup()
+	if err != nil {
+		return "", fmt.Errorf("failed to back up config before migrating: %v", err)
 	}
 
 	// Save the migrated config
 	err = Save(config)
 	if err != nil {
-		return fmt.Errorf("failed to save migrated config: %v", err)
+		return "", fmt.Errorf("failed to save migrated config (the original is in %s): %v", backupPath, err)
 	}
 
 	fmt.Printf("Successfully migrated config from schema version %d to %d\n", originalVersion, config.SchemaVersion)
-	return nil
+	return backupPath, nil
 }
 
 // SaveUIPrefs saves only the UI preferences to the config file
 // This is lightweight and can be called frequently without impacting other config values
 func SaveUIPrefs(prefs UIPreferences) error {
-	config, err := Load()
+	return UpdateUIPrefs(func(p *UIPreferences) { *p = prefs })
+}
+
+// UpdateUIPrefs applies update to the UI preferences currently on disk and writes back
+// only the ui_prefs table. The rest of the file is re-read under a lock at save time,
+// so edits made by other gci processes (or by hand) since startup are preserved.
+func UpdateUIPrefs(update func(*UIPreferences)) error {
+	configPath := Path()
+	if configPath == "" {
+		return fmt.Errorf("unable to determine home directory")
+	}
+	if err := os.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
+		return fmt.Errorf("failed to create config directory: %v", err)
+	}
+
+	unlock, err := lockConfig(configPath)
 	if err != nil {
-		// Create a minimal config -- don't seed with company defaults
-		config = Config{
-			SchemaVersion: CurrentSchemaVersion,
-			DefaultScope:  "assigned_or_reported",
+		return fmt.Errorf("failed to lock config: %v", err)
+	}
+	defer unlock()
+
+	// Read from the XDG path, falling back to the legacy file so its settings carry over
+	sourcePath := configPath
+	if _, err := os.Stat(sourcePath); err != nil {
+		sourcePath = LegacyPath()
+	}
+
+	raw := make(map[string]interface{})
+	var current struct {
+		UIPrefs UIPreferences `toml:"ui_prefs"`
+	}
+	if _, err := os.Stat(sourcePath); err == nil {
+		if _, err := toml.DecodeFile(sourcePath, &raw); err != nil {
+			return errors.NewConfigError("load", fmt.Errorf("failed to decode config file: %v", err))
 		}
+		if _, err := toml.DecodeFile(sourcePath, &current); err != nil {
+			return errors.NewConfigError("load", fmt.Errorf("failed to decode config file: %v", err))
+		}
+	} else {
+		// Create a minimal config -- don't seed with company defaults
+		raw["schema_version"] = CurrentSchemaVersion
+		raw["default_scope"] = "assigned_or_reported"
 	}
 
-	config.UIPrefs = prefs
-	return Save(config)
+	update(&current.UIPrefs)
+	raw["ui_prefs"] = current.UIPrefs
+	return writeConfigFile(configPath, raw)
 }
 
 // GetUIPrefs returns the current UI preferences from the runtime config
@@ -320,3 +453,38 @@ func GetUIPrefs() UIPreferences {
 	config := GetRuntimeConfig()
 	return config.UIPrefs
 }
+
+// uiScopes are the last_scope values a board understands: config names, plus the
+// display names older versions saved
+var uiScopes = map[string]bool{
+	"assigned_or_reported": true, "assigned": true, "reported": true, "unassigned": true,
+	"Assigned or Reported by Me": true, "Assigned to Me": true, "Reported by Me": true, "Unassigned": true,
+}
+
+var uiRowLayouts = map[string]bool{"compact": true, "normal": true, "detailed": true}
+
+// Sanitize resets UI preferences that are stale or malformed instead of letting them
+// misbehave: an unknown last_scope or row_layout, a negative or out-of-range
+// last_selected_col, and column_widths that aren't all positive or don't match the
+// column count. columns <= 0 skips the checks that need the board's column count.
+func (p UIPreferences) Sanitize(columns int) UIPreferences {
+	if p.LastScope != "" && !uiScopes[p.LastScope] {
+		p.LastScope = ""
+	}
+	if p.RowLayout != "" && !uiRowLayouts[p.RowLayout] {
+		p.RowLayout = ""
+	}
+	if p.LastSelectedCol < 0 || (columns > 0 && p.LastSelectedCol >= columns) {
+		p.LastSelectedCol = 0
+	}
+	for _, w := range p.ColumnWidths {
+		if w <= 0 {
+			p.ColumnWidths = nil
+			break
+		}
+	}
+	if columns > 0 && len(p.ColumnWidths) != columns {
+		p.ColumnWidths = nil
+	}
+	return p
+}
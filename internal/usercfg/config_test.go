@@ -92,16 +92,19 @@ func TestEnvVarOverlays(t *testing.T) {
 	origProjects := os.Getenv("GCI_PROJECTS")
 	origScope := os.Getenv("GCI_DEFAULT_SCOPE")
 	origJiraURL := os.Getenv("GCI_JIRA_URL")
+	origDefaultProject := os.Getenv("GCI_DEFAULT_PROJECT")
 	defer func() {
 		os.Setenv("GCI_PROJECTS", origProjects)
 		os.Setenv("GCI_DEFAULT_SCOPE", origScope)
 		os.Setenv("GCI_JIRA_URL", origJiraURL)
+		os.Setenv("GCI_DEFAULT_PROJECT", origDefaultProject)
 	}()
 	
 	// Test GCI_PROJECTS override
 	os.Setenv("GCI_PROJECTS", "FOO,BAR,BAZ")
 	os.Setenv("GCI_DEFAULT_SCOPE", "assigned")
 	os.Setenv("GCI_JIRA_URL", "https://env.example.com")
+	os.Setenv("GCI_DEFAULT_PROJECT", "BAR")
 	
 	config := GetRuntimeConfig()
 	
@@ -122,6 +125,10 @@ func TestEnvVarOverlays(t *testing.T) {
 	if config.JiraURL != "https://env.example.com" {
 		t.Errorf("Expected JIRA URL from env var, got %s", config.JiraURL)
 	}
+
+	if config.DefaultProject != "BAR" {
+		t.Errorf("Expected default project 'BAR' from env var, got %s", config.DefaultProject)
+	}
 }
 
 func TestEnvVarProjectsWithSpaces(t *testing.T) {
@@ -414,10 +421,13 @@ default_scope = "reported"
 	}
 
 	// Run migration
-	err := MigrateAndSave()
+	backupPath, err := MigrateAndSave()
 	if err != nil {
 		t.Fatalf("MigrateAndSave failed: %v", err)
 	}
+	if _, err := os.Stat(backupPath); err != nil {
+		t.Errorf("MigrateAndSave should return the backup it took: %v", err)
+	}
 
 	// Load the migrated file and check it has schema version
 	var migratedConfig Config
@@ -454,7 +464,7 @@ func TestMigrateAlreadyCurrentVersion(t *testing.T) {
 	}
 
 	// Attempt migration - should fail
-	err = MigrateAndSave()
+	_, err = MigrateAndSave()
 	if err == nil {
 		t.Errorf("MigrateAndSave should fail when config is already current version")
 	}
@@ -493,4 +503,126 @@ func TestExampleConfigParses(t *testing.T) {
 	if config.Boards["MYPROJECT_kanban"] != 123 {
 		t.Errorf("Example should have MYPROJECT_kanban board, got %v", config.Boards)
 	}
-}
\ No newline at end of file
+}
+func TestUpdateUIPrefsPreservesOtherSettings(t *testing.T) {
+	tempDir := t.TempDir()
+
+	originalHome := os.Getenv("HOME")
+	defer os.Setenv("HOME", originalHome)
+	os.Setenv("HOME", tempDir)
+
+	configPath := filepath.Join(tempDir, ".config", "gci", "config.toml")
+	if err := os.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
+		t.Fatalf("Failed to create config dir: %v", err)
+	}
+
+	// Simulates a config edited after the board loaded it
+	content := `schema_version = 1
+projects = ["EDITED"]
+jira_url = "https://edited.example.com"
+
+[ui_prefs]
+show_extra_fields = true
+last_scope = "assigned"
+`
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	err := UpdateUIPrefs(func(p *UIPreferences) {
+		p.LastScope = "reported"
+		p.ColumnOrder = []string{"Done", "To Do"}
+	})
+	if err != nil {
+		t.Fatalf("UpdateUIPrefs failed: %v", err)
+	}
+
+	var saved Config
+	md, err := toml.DecodeFile(configPath, &saved)
+	if err != nil {
+		t.Fatalf("Failed to decode saved config: %v", err)
+	}
+	if len(saved.Projects) != 1 || saved.Projects[0] != "EDITED" || saved.JiraURL != "https://edited.example.com" {
+		t.Errorf("Expected other settings preserved, got projects %v url %s", saved.Projects, saved.JiraURL)
+	}
+	if md.IsDefined("on_dirty_tree") {
+		t.Error("Expected defaults not to be written into the file")
+	}
+	if !saved.UIPrefs.ShowExtraFields {
+		t.Error("Expected untouched ui_prefs fields to be preserved")
+	}
+	if saved.UIPrefs.LastScope != "reported" || len(saved.UIPrefs.ColumnOrder) != 2 {
+		t.Errorf("Expected updated ui_prefs, got %+v", saved.UIPrefs)
+	}
+}
+
+func TestWriteConfigFileAtomic(t *testing.T) {
+	tempDir := t.TempDir()
+	configPath := filepath.Join(tempDir, "config.toml")
+
+	original := "projects = [\"KEEP\"]\n"
+	if err := os.WriteFile(configPath, []byte(original), 0600); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	// Channels can't be encoded as TOML, so this fails mid-encode
+	err := writeConfigFile(configPath, map[string]interface{}{"bad": make(chan int)})
+	if err == nil {
+		t.Fatal("Expected encode error")
+	}
+
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatalf("Failed to read config: %v", err)
+	}
+	if string(data) != original {
+		t.Errorf("Expected original config intact, got %q", string(data))
+	}
+	entries, _ := os.ReadDir(tempDir)
+	if len(entries) != 1 {
+		t.Errorf("Expected temp file to be cleaned up, found %d entries", len(entries))
+	}
+
+	// A successful write keeps the existing permissions
+	if err := writeConfigFile(configPath, Config{Projects: []string{"NEW"}}); err != nil {
+		t.Fatalf("writeConfigFile failed: %v", err)
+	}
+	info, err := os.Stat(configPath)
+	if err != nil {
+		t.Fatalf("Failed to stat config: %v", err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("Expected permissions 0600 preserved, got %v", info.Mode().Perm())
+	}
+}
+
+func TestUIPreferencesSanitize(t *testing.T) {
+	stale := UIPreferences{
+		LastScope:       "everything",
+		RowLayout:       "huge",
+		LastSelectedCol: 3,
+		ColumnWidths:    []int{2, 1, 1},
+		LastFilter:      "login",
+	}
+
+	loaded := stale.Sanitize(0)
+	if loaded.LastScope != "" || loaded.RowLayout != "" {
+		t.Errorf("unknown scope and layout should be reset, got %+v", loaded)
+	}
+	if loaded.LastSelectedCol != 3 || len(loaded.ColumnWidths) != 3 {
+		t.Errorf("column checks need the column count, got %+v", loaded)
+	}
+
+	board := stale.Sanitize(4)
+	if board.LastSelectedCol != 3 || len(board.ColumnWidths) != 0 {
+		t.Errorf("widths for 3 columns don't fit 4, got %+v", board)
+	}
+	board = stale.Sanitize(3)
+	if board.LastSelectedCol != 0 || len(board.ColumnWidths) != 3 || board.LastFilter != "login" {
+		t.Errorf("out-of-range column should reset and the rest be kept, got %+v", board)
+	}
+
+	if got := (UIPreferences{ColumnWidths: []int{1, 0, 1}, LastScope: "Assigned to Me"}).Sanitize(0); got.ColumnWidths != nil || got.LastScope == "" {
+		t.Errorf("non-positive widths should drop, legacy scope names stay, got %+v", got)
+	}
+}
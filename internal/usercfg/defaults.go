@@ -7,6 +7,7 @@ func getDefaults() Config {
 		SchemaVersion:   CurrentSchemaVersion,
 		Projects:        nil,
 		DefaultScope:    "assigned_or_reported",
+		OnDirtyTree:     "prompt",
 		JiraURL:         "",
 		Boards:          nil,
 		EnableClaude:    &f,
@@ -18,11 +19,17 @@ func GetAvailableProjects() []string {
 	return GetAvailableProjectsFromRuntime()
 }
 
+// GetAvailableProjectsFromRuntime returns the runtime projects plus "both", the
+// choices for the root --project flag
 func GetAvailableProjectsFromRuntime() []string {
-	// Get the runtime config to include any env var overrides
+	return append(GetRuntimeProjects(), "both")
+}
+
+// GetRuntimeProjects returns the configured projects with env overlays (GCI_PROJECTS)
+// applied. Every command that offers or validates a project uses this list.
+func GetRuntimeProjects() []string {
 	config := GetRuntimeConfig()
 	projects := make([]string, len(config.Projects))
 	copy(projects, config.Projects)
-	projects = append(projects, "both")
 	return projects
 }
@@ -0,0 +1,39 @@
+package usercfg
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/BurntSushi/toml"
+)
+
+// ExportFormats lists the formats accepted by Export.
+var ExportFormats = []string{"toml", "json"}
+
+// Export writes config to w as TOML or JSON. JSON is produced from the TOML
+// encoding, so both formats use the same keys and omit the same empty fields.
+// Config holds token paths, never resolved tokens, so nothing is redacted.
+func Export(w io.Writer, config Config, format string) error {
+	var buf bytes.Buffer
+	if err := toml.NewEncoder(&buf).Encode(config); err != nil {
+		return fmt.Errorf("failed to encode config: %v", err)
+	}
+
+	switch format {
+	case "toml":
+		_, err := w.Write(buf.Bytes())
+		return err
+	case "json":
+		var generic map[string]interface{}
+		if _, err := toml.Decode(buf.String(), &generic); err != nil {
+			return fmt.Errorf("failed to convert config: %v", err)
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(generic)
+	default:
+		return fmt.Errorf("unknown format %q (want toml or json)", format)
+	}
+}
@@ -0,0 +1,46 @@
+package usercfg
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+)
+
+func TestExport(t *testing.T) {
+	config := Config{
+		Projects:        []string{"INF"},
+		JiraURL:         "https://example.atlassian.net",
+		OPJiraTokenPath: "op://vault/jira/token",
+		EmailDomainMap:  map[string]string{"old.com": "new.com"},
+	}
+
+	var tomlOut bytes.Buffer
+	if err := Export(&tomlOut, config, "toml"); err != nil {
+		t.Fatalf("toml export: %v", err)
+	}
+	var roundTrip Config
+	if _, err := toml.Decode(tomlOut.String(), &roundTrip); err != nil {
+		t.Fatalf("toml output does not parse: %v", err)
+	}
+	if roundTrip.OPJiraTokenPath != config.OPJiraTokenPath || roundTrip.EmailDomainMap["old.com"] != "new.com" {
+		t.Errorf("toml round trip lost fields: %+v", roundTrip)
+	}
+
+	var jsonOut bytes.Buffer
+	if err := Export(&jsonOut, config, "json"); err != nil {
+		t.Fatalf("json export: %v", err)
+	}
+	var got map[string]interface{}
+	if err := json.Unmarshal(jsonOut.Bytes(), &got); err != nil {
+		t.Fatalf("json output does not parse: %v", err)
+	}
+	if got["op_jira_token_path"] != "op://vault/jira/token" || got["jira_url"] != "https://example.atlassian.net" {
+		t.Errorf("json output missing keys: %s", jsonOut.String())
+	}
+
+	if err := Export(&bytes.Buffer{}, config, "yaml"); err == nil {
+		t.Error("expected an error for an unknown format")
+	}
+}
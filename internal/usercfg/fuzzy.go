@@ -79,6 +79,22 @@ func FuzzyScore(pattern, target string) int {
 	return (score * 100) / maxScore
 }
 
+// KeyPrefixMatch reports whether a normalized query is the start of an issue key,
+// either the whole key ("inf-12" for INF-123) or its number ("12" for INF-123)
+func KeyPrefixMatch(query, key string) bool {
+	if query == "" {
+		return false
+	}
+	key = NormalizeSearchText(key)
+	if strings.HasPrefix(key, query) {
+		return true
+	}
+	if i := strings.LastIndex(key, "-"); i >= 0 {
+		return strings.HasPrefix(key[i+1:], query)
+	}
+	return false
+}
+
 // NormalizeSearchText normalizes text for searching by removing common punctuation
 // and converting to lowercase
 func NormalizeSearchText(text string) string {
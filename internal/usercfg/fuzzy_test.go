@@ -83,4 +83,23 @@ func TestNormalizeSearchText(t *testing.T) {
 			t.Errorf("NormalizeSearchText(%q) = %q, expected %q", test.input, result, test.expected)
 		}
 	}
-}
\ No newline at end of file
+}
+func TestKeyPrefixMatch(t *testing.T) {
+	tests := []struct {
+		query, key string
+		want       bool
+	}{
+		{"inf-12", "INF-123", true},
+		{"inf", "INF-123", true},
+		{"12", "INF-123", true},
+		{"123", "INF-123", true},
+		{"23", "INF-123", false},
+		{"1234", "INF-123", false},
+		{"", "INF-123", false},
+	}
+	for _, tt := range tests {
+		if got := KeyPrefixMatch(tt.query, tt.key); got != tt.want {
+			t.Errorf("KeyPrefixMatch(%q, %q) = %v, want %v", tt.query, tt.key, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,8 @@
+//go:build windows
+
+package usercfg
+
+// lockConfig is a no-op where flock is unavailable; writes are still whole-file
+func lockConfig(configPath string) (func(), error) {
+	return func() {}, nil
+}
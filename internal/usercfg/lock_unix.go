@@ -0,0 +1,25 @@
+//go:build !windows
+
+package usercfg
+
+import (
+	"os"
+	"syscall"
+)
+
+// lockConfig takes an exclusive advisory lock on a sidecar lock file so concurrent gci
+// processes don't interleave read-modify-write cycles on the config
+func lockConfig(configPath string) (func(), error) {
+	f, err := os.OpenFile(configPath+".lock", os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, err
+	}
+	if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return func() {
+		syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+		f.Close()
+	}, nil
+}
@@ -0,0 +1,131 @@
+package usercfg
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/BurntSushi/toml"
+)
+
+// RepoConfigFile is the per-repo config file read from the repository root
+const RepoConfigFile = ".gci.toml"
+
+// repoConfig is the subset of keys a repository may set. Anyone can commit a
+// .gci.toml, so nothing here can run commands, read secrets, or change where
+// credentials are sent.
+type repoConfig struct {
+	Projects       []string `toml:"projects"`
+	DefaultProject string   `toml:"default_project"`
+	DefaultScope   string   `toml:"default_scope"`
+	JiraURL        string   `toml:"jira_url"`
+}
+
+// repoScopes lists the default_scope values a .gci.toml may set, matching gci jql --scope
+var repoScopes = []string{"assigned_or_reported", "assigned", "reported", "unassigned"}
+
+// repoRoot returns the top level of the git repository containing the working
+// directory, or "" outside a repository. Variable so tests can stub it.
+var repoRoot = func() string {
+	out, err := exec.Command("git", "rev-parse", "--show-toplevel").Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// RepoConfigPath returns the .gci.toml of the current repository, or "" when there
+// is none
+func RepoConfigPath() string {
+	root := repoRoot()
+	if root == "" {
+		return ""
+	}
+	path := filepath.Join(root, RepoConfigFile)
+	if _, err := os.Stat(path); err != nil {
+		return ""
+	}
+	return path
+}
+
+// repoState is the current repository's .gci.toml, read once per process so its
+// warnings print once even though GetRuntimeConfig runs several times
+var repoState struct {
+	once      sync.Once
+	path      string
+	config    repoConfig
+	ok        bool
+	urlWarned sync.Once
+}
+
+// loadRepoConfig returns the repository's .gci.toml and its path; ok is false when
+// there is none or it can't be read
+func loadRepoConfig() (repoConfig, string, bool) {
+	repoState.once.Do(func() {
+		path := RepoConfigPath()
+		if path == "" {
+			return
+		}
+		var repo repoConfig
+		meta, err := toml.DecodeFile(path, &repo)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: ignoring %s: %v\n", path, err)
+			return
+		}
+		for _, key := range meta.Undecoded() {
+			fmt.Fprintf(os.Stderr, "Warning: ignoring %s in %s (repos may set projects, default_project, default_scope, jira_url)\n", key, path)
+		}
+		if repo.DefaultScope != "" && !slices.Contains(repoScopes, repo.DefaultScope) {
+			fmt.Fprintf(os.Stderr, "Warning: ignoring default_scope %q in %s (valid: %s)\n", repo.DefaultScope, path, strings.Join(repoScopes, ", "))
+			repo.DefaultScope = ""
+		}
+		repoState.path, repoState.config, repoState.ok = path, repo, true
+	})
+	return repoState.config, repoState.path, repoState.ok
+}
+
+// applyRepoOverlay applies the repository's .gci.toml over the user config. Keys
+// outside repoConfig are ignored with a warning, and jira_url is only honored on the
+// same site (scheme and host) as the user's own, so a cloned repo can't send the API
+// token elsewhere or over plain http.
+func applyRepoOverlay(config Config) Config {
+	repo, path, ok := loadRepoConfig()
+	if !ok {
+		return config
+	}
+
+	if len(repo.Projects) > 0 {
+		config.Projects = repo.Projects
+	}
+	if repo.DefaultProject != "" {
+		config.DefaultProject = repo.DefaultProject
+	}
+	if repo.DefaultScope != "" {
+		config.DefaultScope = repo.DefaultScope
+	}
+	if repo.JiraURL != "" {
+		if sameSite(repo.JiraURL, config.JiraURL) {
+			config.JiraURL = repo.JiraURL
+		} else {
+			userURL := config.JiraURL
+			repoState.urlWarned.Do(func() {
+				fmt.Fprintf(os.Stderr, "Warning: ignoring jira_url %s in %s: it isn't your JIRA site (%s)\n", repo.JiraURL, path, userURL)
+			})
+		}
+	}
+	return config
+}
+
+// sameSite reports whether two URLs have the same https scheme and host
+func sameSite(a, b string) bool {
+	ua, errA := url.Parse(a)
+	ub, errB := url.Parse(b)
+	return errA == nil && errB == nil && ua.Host != "" &&
+		strings.EqualFold(ua.Scheme, "https") && strings.EqualFold(ub.Scheme, "https") &&
+		strings.EqualFold(ua.Host, ub.Host)
+}
@@ -0,0 +1,111 @@
+package usercfg
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+// rereadRepoConfig forgets the .gci.toml read so far, as a new process would
+func rereadRepoConfig() {
+	repoState.once = sync.Once{}
+	repoState.urlWarned = sync.Once{}
+	repoState.ok = false
+}
+
+func TestRepoConfigOverlay(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("GCI_PROJECTS", "")
+	repo := t.TempDir()
+	origRoot := repoRoot
+	defer func() { repoRoot = origRoot }()
+	repoRoot = func() string { return repo }
+	rereadRepoConfig()
+	defer rereadRepoConfig()
+
+	if err := Save(Config{JiraURL: "https://acme.atlassian.net", Projects: []string{"GLOBAL"}}); err != nil {
+		t.Fatal(err)
+	}
+	if got := GetRuntimeConfig().Projects; len(got) != 1 || got[0] != "GLOBAL" {
+		t.Fatalf("without a repo file the user config applies, got %v", got)
+	}
+	userScope := GetRuntimeConfig().DefaultScope
+
+	repoFile := `projects = ["WEB", "API"]
+default_project = "WEB"
+jira_url = "https://acme.atlassian.net/"
+op_jira_token_path = "op://Shared/evil/credential"
+`
+	if err := os.WriteFile(filepath.Join(repo, RepoConfigFile), []byte(repoFile), 0644); err != nil {
+		t.Fatal(err)
+	}
+	rereadRepoConfig()
+	config := GetRuntimeConfig()
+	if len(config.Projects) != 2 || config.Projects[0] != "WEB" || config.DefaultProject != "WEB" {
+		t.Errorf("repo file should set projects and default_project, got %v %q", config.Projects, config.DefaultProject)
+	}
+	if config.JiraURL != "https://acme.atlassian.net/" {
+		t.Errorf("jira_url on the same site should apply, got %s", config.JiraURL)
+	}
+	if config.OPJiraTokenPath != "" {
+		t.Error("keys outside the repo subset must be ignored")
+	}
+
+	t.Setenv("GCI_PROJECTS", "ENV")
+	if got := GetRuntimeConfig().Projects; len(got) != 1 || got[0] != "ENV" {
+		t.Errorf("env vars should win over the repo file, got %v", got)
+	}
+
+	if err := os.WriteFile(filepath.Join(repo, RepoConfigFile), []byte(`default_scope = "reported"`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	rereadRepoConfig()
+	if got := GetRuntimeConfig().DefaultScope; got != "reported" {
+		t.Errorf("a valid default_scope should apply, got %q", got)
+	}
+
+	if err := os.WriteFile(filepath.Join(repo, RepoConfigFile), []byte(`default_scope = "everything"`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	rereadRepoConfig()
+	if got := GetRuntimeConfig().DefaultScope; got != userScope {
+		t.Errorf("an unknown default_scope must be ignored, got %q", got)
+	}
+
+	if err := os.WriteFile(filepath.Join(repo, RepoConfigFile), []byte(`jira_url = "https://attacker.example.com"`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	rereadRepoConfig()
+	if got := GetRuntimeConfig().JiraURL; got != "https://acme.atlassian.net" {
+		t.Errorf("jira_url on another host must be ignored, got %s", got)
+	}
+
+	if err := os.WriteFile(filepath.Join(repo, RepoConfigFile), []byte(`jira_url = "http://acme.atlassian.net"`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	rereadRepoConfig()
+	if got := GetRuntimeConfig().JiraURL; got != "https://acme.atlassian.net" {
+		t.Errorf("jira_url downgrading to http must be ignored, got %s", got)
+	}
+}
+
+func TestRepoConfigReadOnce(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	repo := t.TempDir()
+	origRoot := repoRoot
+	defer func() { repoRoot = origRoot }()
+	calls := 0
+	repoRoot = func() string { calls++; return repo }
+	rereadRepoConfig()
+	defer rereadRepoConfig()
+
+	if err := os.WriteFile(filepath.Join(repo, RepoConfigFile), []byte("projects = [\"WEB\"]\nunknown = 1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	GetRuntimeConfig()
+	GetRuntimeConfig()
+	if calls != 1 {
+		t.Errorf(".gci.toml should be located and read once per process, got %d lookups", calls)
+	}
+}
@@ -3,20 +3,68 @@ package version
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
 	semver "github.com/Masterminds/semver/v3"
 	selfupdate "github.com/creativeprojects/go-selfupdate"
+	"github.com/google/go-github/v74/github"
 )
 
 const (
 	updateCheckTTL  = 24 * time.Hour
+	updateFailedTTL = 72 * time.Hour // back off longer when GitHub was unreachable
 	updateCacheFile = "update_check.json"
 	githubSlug      = "kesensoy/gci"
+
+	// DefaultCheckTimeout bounds the background update check unless overridden
+	DefaultCheckTimeout = 5 * time.Second
 )
 
+// githubAPIURL is the GitHub API that update checks list releases from
+var githubAPIURL = "https://api.github.com/"
+
+// CheckOptions configures how update checks reach GitHub
+type CheckOptions struct {
+	Timeout   time.Duration     // how long to wait for GitHub; <= 0 uses DefaultCheckTimeout
+	Transport http.RoundTripper // e.g. the JIRA client's, for its proxy/CA settings; nil uses http.DefaultTransport
+}
+
+var (
+	checkOptionsMu sync.RWMutex
+	checkOptions   CheckOptions
+)
+
+// ConfigureCheck sets the options used by later update checks
+func ConfigureCheck(opts CheckOptions) {
+	checkOptionsMu.Lock()
+	defer checkOptionsMu.Unlock()
+	checkOptions = opts
+}
+
+// CheckTimeout returns the configured update check timeout
+func CheckTimeout() time.Duration {
+	checkOptionsMu.RLock()
+	defer checkOptionsMu.RUnlock()
+	if checkOptions.Timeout <= 0 {
+		return DefaultCheckTimeout
+	}
+	return checkOptions.Timeout
+}
+
+// checkClient returns an HTTP client on the configured transport
+func checkClient(timeout time.Duration) *http.Client {
+	checkOptionsMu.RLock()
+	defer checkOptionsMu.RUnlock()
+	return &http.Client{Timeout: timeout, Transport: checkOptions.Transport}
+}
+
 // UpdateCheckResult holds the outcome of a background update check.
 type UpdateCheckResult struct {
 	NewVersion string // empty means no update available (or check skipped/failed)
@@ -26,6 +74,7 @@ type updateCache struct {
 	LatestVersion  string    `json:"latest_version"`
 	CheckedVersion string    `json:"checked_version"` // version that was running when we last checked
 	Timestamp      time.Time `json:"timestamp"`
+	Failed         bool      `json:"failed,omitempty"` // GitHub was unreachable; kept for updateFailedTTL
 }
 
 // StartUpdateCheck launches a background goroutine that checks for updates.
@@ -66,37 +115,103 @@ func checkForUpdate(current string, skipCache bool) string {
 		}
 	}
 
-	// Cache miss, stale, or user updated — query GitHub
-	source, err := NewPublicGitHubSource()
+	// Cache miss, stale, or user updated — query GitHub on the same transport as
+	// JIRA requests, so proxy and CA settings apply here too
+	timeout := CheckTimeout()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	latestVer, err := detectLatest(ctx, newCheckSource(checkClient(timeout), githubAPIURL))
 	if err != nil {
+		// Cache current version with a longer backoff so we don't retry on every command
+		saveUpdateCacheResult(UpdateCachePath(), current, current, true)
 		return ""
 	}
+	if latestVer == "" {
+		saveUpdateCache(current, current)
+		return ""
+	}
+
+	saveUpdateCache(latestVer, current)
+	if !isNewerThan(latestVer, current) {
+		return ""
+	}
+	return latestVer
+}
 
+// detectLatest returns the version of the newest release with a binary for this
+// platform and its checksums, or "" if there is none
+func detectLatest(ctx context.Context, source selfupdate.Source) (string, error) {
 	updater, err := selfupdate.NewUpdater(selfupdate.Config{
 		Source:    source,
 		Validator: &selfupdate.ChecksumValidator{UniqueFilename: "checksums.txt"},
 	})
 	if err != nil {
-		return ""
+		return "", err
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
 	latest, found, err := updater.DetectLatest(ctx, selfupdate.ParseSlug(githubSlug))
-	if err != nil || !found {
-		// Cache current version so we don't hammer GitHub when offline
-		saveUpdateCache(current, current)
-		return ""
+	if err != nil {
+		return "", err
+	}
+	if !found {
+		return "", nil
 	}
+	return latest.Version(), nil
+}
 
-	latestVer := latest.Version()
-	saveUpdateCache(latestVer, current)
+// checkSource is a selfupdate source for gci's public GitHub releases on a given
+// client. selfupdate's GitHubSource always uses http.DefaultClient, which would skip
+// the configured transport and timeout.
+type checkSource struct {
+	api    *github.Client
+	client *http.Client
+}
 
-	if latest.LessOrEqual(current) {
-		return ""
+// newCheckSource creates a checkSource for the GitHub API at baseURL; like
+// NewPublicGitHubSource it sends no GITHUB_TOKEN
+func newCheckSource(client *http.Client, baseURL string) *checkSource {
+	api := github.NewClient(client)
+	if u, err := url.Parse(baseURL); err == nil {
+		api.BaseURL = u
 	}
-	return latestVer
+	return &checkSource{api: api, client: client}
+}
+
+// ListReleases returns the repository's releases; a missing repository has none
+func (s *checkSource) ListReleases(ctx context.Context, repository selfupdate.Repository) ([]selfupdate.SourceRelease, error) {
+	owner, repo, err := repository.GetSlug()
+	if err != nil {
+		return nil, err
+	}
+	rels, res, err := s.api.Repositories.ListReleases(ctx, owner, repo, nil)
+	if err != nil {
+		if res != nil && res.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	releases := make([]selfupdate.SourceRelease, len(rels))
+	for i, rel := range rels {
+		releases[i] = selfupdate.NewGitHubRelease(rel)
+	}
+	return releases, nil
+}
+
+// DownloadReleaseAsset downloads a release asset on the source's client
+func (s *checkSource) DownloadReleaseAsset(ctx context.Context, rel *selfupdate.Release, assetID int64) (io.ReadCloser, error) {
+	if rel == nil {
+		return nil, selfupdate.ErrInvalidRelease
+	}
+	owner, repo, err := selfupdate.ParseSlug(githubSlug).GetSlug()
+	if err != nil {
+		return nil, err
+	}
+	rc, _, err := s.api.Repositories.DownloadReleaseAsset(ctx, owner, repo, assetID, s.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download release asset %d: %w", assetID, err)
+	}
+	return rc, nil
 }
 
 // NewPublicGitHubSource creates a GitHubSource that ignores any
@@ -125,7 +240,8 @@ func isNewerThan(latest, current string) bool {
 
 // Cache helpers — inner functions take a path for testability.
 
-func updateCachePath() string {
+// UpdateCachePath returns where the last update check result is cached
+func UpdateCachePath() string {
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
 		return ""
@@ -134,11 +250,11 @@ func updateCachePath() string {
 }
 
 func loadUpdateCache() (string, string, bool) {
-	return loadUpdateCacheFrom(updateCachePath())
+	return loadUpdateCacheFrom(UpdateCachePath())
 }
 
 func saveUpdateCache(latestVersion, checkedVersion string) {
-	saveUpdateCacheTo(updateCachePath(), latestVersion, checkedVersion)
+	saveUpdateCacheTo(UpdateCachePath(), latestVersion, checkedVersion)
 }
 
 func loadUpdateCacheFrom(path string) (string, string, bool) {
@@ -156,7 +272,11 @@ func loadUpdateCacheFrom(path string) (string, string, bool) {
 		return "", "", false
 	}
 
-	if time.Since(cache.Timestamp) > updateCheckTTL {
+	ttl := updateCheckTTL
+	if cache.Failed {
+		ttl = updateFailedTTL
+	}
+	if time.Since(cache.Timestamp) > ttl {
 		return "", "", false
 	}
 
@@ -164,6 +284,10 @@ func loadUpdateCacheFrom(path string) (string, string, bool) {
 }
 
 func saveUpdateCacheTo(path string, latestVersion, checkedVersion string) {
+	saveUpdateCacheResult(path, latestVersion, checkedVersion, false)
+}
+
+func saveUpdateCacheResult(path string, latestVersion, checkedVersion string, failed bool) {
 	if path == "" {
 		return
 	}
@@ -172,6 +296,7 @@ func saveUpdateCacheTo(path string, latestVersion, checkedVersion string) {
 		LatestVersion:  latestVersion,
 		CheckedVersion: checkedVersion,
 		Timestamp:      time.Now(),
+		Failed:         failed,
 	}
 
 	data, err := json.Marshal(cache)
@@ -180,5 +305,25 @@ func saveUpdateCacheTo(path string, latestVersion, checkedVersion string) {
 	}
 
 	os.MkdirAll(filepath.Dir(path), 0755)
-	os.WriteFile(path, data, 0644)
+
+	// Write to a temp file and rename over the cache, so concurrent gci processes
+	// never leave a partially written file behind
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return
+	}
+	tmpPath := tmp.Name()
+	defer os.Remove(tmpPath) // no-op once renamed
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return
+	}
+	if err := tmp.Close(); err != nil {
+		return
+	}
+	if err := os.Chmod(tmpPath, 0644); err != nil {
+		return
+	}
+	os.Rename(tmpPath, path)
 }
@@ -1,9 +1,15 @@
 package version
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"runtime"
+	"sync"
 	"testing"
 	"time"
 )
@@ -122,3 +128,129 @@ func TestLoadCacheFrom_InvalidJSON(t *testing.T) {
 		t.Fatal("expected cache miss for invalid JSON")
 	}
 }
+
+func TestSaveCacheTo_ConcurrentWriters(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "update_check.json")
+	saveUpdateCacheTo(path, "1.0.0", "1.0.0")
+
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+	for _, v := range []string{"1.2.0", "1.10.0-with-a-longer-version-string"} {
+		wg.Add(1)
+		go func(v string) {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				saveUpdateCacheTo(path, v, "1.0.0")
+			}
+		}(v)
+	}
+	go func() {
+		wg.Wait()
+		close(stop)
+	}()
+
+	for {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("cache file missing during concurrent writes: %v", err)
+		}
+		var cache updateCache
+		if err := json.Unmarshal(data, &cache); err != nil {
+			t.Fatalf("cache file did not parse during concurrent writes: %v (%q)", err, data)
+		}
+		select {
+		case <-stop:
+			if matches, _ := filepath.Glob(filepath.Join(dir, "*.tmp-*")); len(matches) != 0 {
+				t.Errorf("temp files left behind: %v", matches)
+			}
+			return
+		default:
+		}
+	}
+}
+
+func TestDetectLatest(t *testing.T) {
+	binary := fmt.Sprintf("gci_%s_%s.tar.gz", runtime.GOOS, runtime.GOARCH)
+	releases := fmt.Sprintf(`[
+		{"id": 2, "tag_name": "v1.5.0", "assets": [{"id": 20, "name": "gci_plan9_mips.tar.gz"}, {"id": 21, "name": "checksums.txt"}]},
+		{"id": 1, "tag_name": "v1.4.0", "assets": [{"id": 10, "name": %q}, {"id": 11, "name": "checksums.txt"}]}
+	]`, binary)
+
+	var status int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/"+githubSlug+"/releases" {
+			http.NotFound(w, r)
+			return
+		}
+		w.WriteHeader(status)
+		if status == http.StatusOK {
+			w.Write([]byte(releases))
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	source := newCheckSource(server.Client(), server.URL+"/")
+
+	// v1.5.0 has no binary for this platform, so v1.4.0 is the latest usable release
+	status = http.StatusOK
+	if got, err := detectLatest(ctx, source); err != nil || got != "1.4.0" {
+		t.Errorf("latest: got %q, %v", got, err)
+	}
+	status = http.StatusNotFound
+	if got, err := detectLatest(ctx, source); err != nil || got != "" {
+		t.Errorf("no releases: got %q, %v", got, err)
+	}
+	status = http.StatusForbidden
+	if _, err := detectLatest(ctx, source); err == nil {
+		t.Error("expected an error for a non-200 response")
+	}
+
+	// A release without checksums can't be installed by gci update
+	releases = fmt.Sprintf(`[{"id": 1, "tag_name": "v1.4.0", "assets": [{"id": 10, "name": %q}]}]`, binary)
+	status = http.StatusOK
+	if got, err := detectLatest(ctx, source); got != "" || err == nil {
+		t.Errorf("missing checksums: got %q, %v", got, err)
+	}
+}
+
+func TestLoadCacheFrom_FailureBackoff(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "update_check.json")
+
+	write := func(age time.Duration, failed bool) {
+		data, _ := json.Marshal(updateCache{
+			LatestVersion:  "1.0.0",
+			CheckedVersion: "1.0.0",
+			Timestamp:      time.Now().Add(-age),
+			Failed:         failed,
+		})
+		os.WriteFile(path, data, 0644)
+	}
+
+	write(48*time.Hour, false)
+	if _, _, ok := loadUpdateCacheFrom(path); ok {
+		t.Error("a successful check should expire after a day")
+	}
+	write(48*time.Hour, true)
+	if _, _, ok := loadUpdateCacheFrom(path); !ok {
+		t.Error("a failed check should be kept longer to back off")
+	}
+	write(96*time.Hour, true)
+	if _, _, ok := loadUpdateCacheFrom(path); ok {
+		t.Error("a failed check should eventually expire")
+	}
+}
+
+func TestCheckTimeout(t *testing.T) {
+	defer ConfigureCheck(CheckOptions{})
+
+	if got := CheckTimeout(); got != DefaultCheckTimeout {
+		t.Errorf("default timeout = %v, want %v", got, DefaultCheckTimeout)
+	}
+	ConfigureCheck(CheckOptions{Timeout: 12 * time.Second})
+	if got := CheckTimeout(); got != 12*time.Second {
+		t.Errorf("configured timeout = %v, want 12s", got)
+	}
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"gci/internal/errors"
+	"gci/internal/httputil"
+	"gci/internal/jira"
+	"gci/internal/logger"
+)
+
+// issueWriteRetries is how many times a write is re-sent after a 409 conflict
+const issueWriteRetries = 1
+
+// issueWrite describes a mutation of a single issue (transition, assign, edit)
+type issueWrite struct {
+	Key    string
+	Method string
+	Path   string // appended to /rest/api/3/issue/{key}, e.g. "/transitions"
+	Body   interface{}
+	// Retry reports whether re-sending Body is still correct against the issue as
+	// refetched after a 409. Nil means conflicts are never retried.
+	Retry func(current JiraIssue) bool
+}
+
+// doIssueWrite sends w and handles 409 Conflict separately from the transient 5xx
+// retries in httputil: the issue is refetched first, and the write is only re-sent when
+// w.Retry accepts the fresh state. Otherwise the caller gets NewIssueChangedError so the
+// user refreshes instead of silently overwriting someone else's change.
+func doIssueWrite(config *Config, w issueWrite) error {
+	var payload []byte
+	if w.Body != nil {
+		var err error
+		if payload, err = json.Marshal(w.Body); err != nil {
+			return fmt.Errorf("failed to marshal request: %w", err)
+		}
+	}
+
+	client := httputil.NewDefaultClient()
+	url := fmt.Sprintf("%s/rest/api/3/issue/%s%s", config.JiraURL, w.Key, w.Path)
+
+	for attempt := 0; ; attempt++ {
+		status, body, err := sendIssueWrite(client, config, w.Method, url, payload)
+		if err != nil {
+			return fmt.Errorf("JIRA request failed: %w", err)
+		}
+		if status >= 200 && status < 300 {
+			return nil
+		}
+		if status != http.StatusConflict {
+			return errors.NewHttpError(status, body)
+		}
+
+		logger.JIRA("%s %s returned 409, refetching %s", w.Method, url, w.Key)
+		current, err := fetchIssueDetails(config, w.Key)
+		if err != nil {
+			return fmt.Errorf("%w (refetch after conflict failed: %v)", errors.NewIssueChangedError(w.Key), err)
+		}
+		if attempt >= issueWriteRetries || w.Retry == nil || !w.Retry(current) {
+			return errors.NewIssueChangedError(w.Key)
+		}
+	}
+}
+
+// sendIssueWrite performs one write request and returns the status and a truncated body
+func sendIssueWrite(client *httputil.RetryableClient, config *Config, method, url string, payload []byte) (int, string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), httputil.Timeout(httputil.DefaultTimeout))
+	defer cancel()
+
+	req, err := http.NewRequest(method, url, bytes.NewReader(payload))
+	if err != nil {
+		return 0, "", err
+	}
+	req.SetBasicAuth(config.Email, config.APIToken)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	logger.HTTP(method, url)
+	resp, err := client.DoWithRetry(ctx, req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	return resp.StatusCode, string(body), nil
+}
+
+// assignIssue sets the assignee of key to accountId. A conflicting concurrent change is
+// retried as long as the issue still exists; the assignee PUT is idempotent.
+func assignIssue(config *Config, key, accountId string) error {
+	return doIssueWrite(config, issueWrite{
+		Key:    key,
+		Method: "PUT",
+		Path:   "/assignee",
+		Body:   map[string]string{"accountId": accountId},
+		Retry:  func(JiraIssue) bool { return true },
+	})
+}
+
+// logWork adds a worklog entry to key, optionally setting the remaining estimate.
+// Worklogs are not idempotent, so a 409 is surfaced rather than re-sent.
+func logWork(config *Config, key, timeSpent, remaining string) error {
+	worklog, path, err := jira.NewWorklog(timeSpent, remaining)
+	if err != nil {
+		return err
+	}
+	return doIssueWrite(config, issueWrite{
+		Key:    key,
+		Method: "POST",
+		Path:   path,
+		Body:   worklog,
+	})
+}
+
+// transitionIssue moves issue through the transition named by want (id, transition
+// name, or target status). It returns skip=true with a reason, and no error, when the
+// issue is already there or the workflow doesn't offer the transition right now.
+func transitionIssue(config *Config, issue JiraIssue, want string) (skip bool, reason string, err error) {
+	if strings.EqualFold(issue.Fields.Status.Name, want) {
+		return true, fmt.Sprintf("%s is already %s", issue.Key, issue.Fields.Status.Name), nil
+	}
+	transitions, err := jira.FetchTransitions(config.JiraURL, config.Email, config.APIToken, issue.Key)
+	if err != nil {
+		return false, "", err
+	}
+	t, ok := jira.MatchTransition(transitions, want)
+	if !ok {
+		return true, fmt.Sprintf("transition %q is not available for %s", want, issue.Key), nil
+	}
+	if strings.EqualFold(issue.Fields.Status.Name, t.To.Name) {
+		return true, fmt.Sprintf("%s is already %s", issue.Key, issue.Fields.Status.Name), nil
+	}
+
+	err = doIssueWrite(config, issueWrite{
+		Key:    issue.Key,
+		Method: "POST",
+		Path:   "/transitions",
+		Body:   map[string]interface{}{"transition": map[string]string{"id": t.ID}},
+		// Someone else may have moved it meanwhile; only re-send if it's still where we left it
+		Retry: func(current JiraIssue) bool {
+			return current.Fields.Status.Name == issue.Fields.Status.Name
+		},
+	})
+	if err != nil {
+		return false, "", err
+	}
+	return false, fmt.Sprintf("%s moved to %s", issue.Key, t.To.Name), nil
+}
+
+// defaultStartTransition is where S moves an issue when on_start_transition is unset
+const defaultStartTransition = "In Progress"
+
+// startTransitionTarget returns the transition S applies: on_start_transition, else
+// defaultStartTransition
+func startTransitionTarget(cfg *Config) string {
+	if cfg.OnStartTransition != "" {
+		return cfg.OnStartTransition
+	}
+	return defaultStartTransition
+}
+
+// startIssueTransition applies on_start_transition once work on issue has started.
+// Failures are reported but never block the branch/worktree/Claude flow.
+func startIssueTransition(cfg *Config, issue JiraIssue) {
+	skipped, reason, err := transitionIssue(cfg, issue, cfg.OnStartTransition)
+	switch {
+	case err != nil:
+		fmt.Printf("\033[93mCould not transition %s: %v\033[0m\n", issue.Key, err)
+	case skipped:
+		notef("\033[90mSkipping on_start_transition: %s\033[0m\n", reason)
+	default:
+		notef("\033[92m%s\033[0m\n", reason)
+	}
+}
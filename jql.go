@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"gci/internal/jira"
+	"gci/internal/usercfg"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	jqlScope   string
+	jqlProject string
+	jqlAll     bool
+)
+
+var jqlCmd = &cobra.Command{
+	Use:   "jql",
+	Short: "Print the JQL gci would send, without running it",
+	Long: `Print the queries behind the issue picker (gci) and each board column, built
+from your config and flags exactly as those commands build them. Nothing is sent to
+JIRA, so status categories use status_categories or the English names; gci board
+looks up localized names at startup.`,
+	Example: `  gci jql
+  gci jql --scope unassigned -p INF
+  gci jql --all`,
+	RunE: runJQL,
+}
+
+// validScopeNames lists the scope values accepted by default_scope and gci jql --scope
+var validScopeNames = []string{"assigned_or_reported", "assigned", "reported", "unassigned"}
+
+func runJQL(cmd *cobra.Command, args []string) error {
+	userConfig := usercfg.GetRuntimeConfig()
+	if len(userConfig.Projects) == 0 {
+		return fmt.Errorf("no projects configured; run: gci setup")
+	}
+
+	selected, err := withDefaultProject(userConfig, jqlProject)
+	if err != nil {
+		return err
+	}
+	projects, err := selectProjects(userConfig, selected)
+	if err != nil {
+		return err
+	}
+
+	scope, _ := scopeForProjects(userConfig, projects)
+	if jqlScope != "" {
+		if !containsString(validScopeNames, jqlScope) {
+			return fmt.Errorf("invalid scope %q (valid: %s)", jqlScope, strings.Join(validScopeNames, ", "))
+		}
+		scope = jqlScope
+	}
+
+	config := &Config{
+		Projects:            projects,
+		All:                 jqlAll,
+		DefaultScope:        scope,
+		StatusCategoryNames: userConfig.StatusCategories,
+		PickerStatuses:      userConfig.PickerStatuses,
+	}
+	printJQL(os.Stdout, config, parseScopeFilter(scope))
+	return nil
+}
+
+// printJQL writes the picker query and one query per board column
+func printJQL(w io.Writer, config *Config, scope scopeFilter) {
+	fmt.Fprintf(w, "# gci (issue picker)\n%s\n", pickerJQL(config))
+
+	columns := []struct{ title, category string }{
+		{"To Do", jira.StatusCategoryNew},
+		{"In Progress", jira.StatusCategoryIndeterminate},
+		{"Done", jira.StatusCategoryDone},
+	}
+	for _, c := range columns {
+		fmt.Fprintf(w, "\n# gci board: %s (%s)\n%s\n", c.title, scopeToString(scope),
+			columnJQL(config, config.statusCategoryName(c.category), scope))
+	}
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestPrintJQL(t *testing.T) {
+	config := &Config{
+		Projects:            []string{"INF"},
+		DefaultScope:        "unassigned",
+		StatusCategoryNames: map[string]string{"done": "Fertig"},
+	}
+
+	var out bytes.Buffer
+	printJQL(&out, config, parseScopeFilter(config.DefaultScope))
+	got := out.String()
+
+	for _, want := range []string{
+		`project = INF AND statusCategory != "Fertig" AND assignee is EMPTY ORDER BY created`,
+		`project = INF AND statusCategory = "To Do" AND assignee is EMPTY ORDER BY updated DESC`,
+		`project = INF AND statusCategory = "Fertig" AND assignee is EMPTY ORDER BY updated DESC`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output missing %q:\n%s", want, got)
+		}
+	}
+
+	config.All = true
+	if got := pickerJQL(config); strings.Contains(got, "assignee") {
+		t.Errorf("--all picker query should drop the scope: %s", got)
+	}
+}
@@ -25,60 +25,11 @@ func TestLargeListRendering(t *testing.T) {
 	syntheticIssues := make([]JiraIssue, numIssues)
 
 	for i := 0; i < numIssues; i++ {
-		syntheticIssues[i] = JiraIssue{
-			Key: fmt.Sprintf("TEST-%d", i+1),
-			Fields: struct {
-				Summary     string `json:"summary"`
-				Description *struct {
-					Content []struct {
-						Type    string `json:"type"`
-						Content []struct {
-							Type string `json:"type"`
-							Text string `json:"text,omitempty"`
-						} `json:"content,omitempty"`
-					} `json:"content,omitempty"`
-				} `json:"description"`
-				Project struct {
-					Key string `json:"key"`
-				} `json:"project"`
-				IssueType struct {
-					Name    string `json:"name"`
-					Subtask bool   `json:"subtask"`
-				} `json:"issuetype"`
-				Parent struct {
-					Key string `json:"key"`
-				} `json:"parent"`
-				Status struct {
-					Name           string `json:"name"`
-					StatusCategory struct {
-						Name string `json:"name"`
-					} `json:"statusCategory"`
-				} `json:"status"`
-				Assignee struct {
-					DisplayName string `json:"displayName"`
-					Name        string `json:"name"`
-				} `json:"assignee"`
-				Priority struct {
-					Name string `json:"name"`
-				} `json:"priority"`
-			}{
-				Summary: fmt.Sprintf("Test issue number %d - this is a longer summary to simulate real issue content", i+1),
-				Project: struct {
-					Key string `json:"key"`
-				}{Key: "TEST"},
-				Status: struct {
-					Name           string `json:"name"`
-					StatusCategory struct {
-						Name string `json:"name"`
-					} `json:"statusCategory"`
-				}{
-					Name: "To Do",
-					StatusCategory: struct {
-						Name string `json:"name"`
-					}{Name: "To Do"},
-				},
-			},
-		}
+		syntheticIssues[i] = JiraIssue{Key: fmt.Sprintf("TEST-%d", i+1)}
+		syntheticIssues[i].Fields.Summary = fmt.Sprintf("Test issue number %d - this is a longer summary to simulate real issue content", i+1)
+		syntheticIssues[i].Fields.Project.Key = "TEST"
+		syntheticIssues[i].Fields.Status.Name = "To Do"
+		syntheticIssues[i].Fields.Status.StatusCategory.Name = "To Do"
 	}
 
 	// Distribute issues across columns to simulate a real board
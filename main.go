@@ -3,17 +3,24 @@ package main
 import (
 	"bytes"
 	"context"
+	"encoding/csv"
 	"encoding/json"
+	stderrors "errors"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
+	"net/mail"
+	"net/url"
 	"os"
 	"os/exec"
 	"os/signal"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
+	"strconv"
+	"sync"
 	"syscall"
 	"time"
 
@@ -25,12 +32,14 @@ import (
 	"gci/internal/version"
 
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/AlecAivazis/survey/v2/terminal"
 	selfupdate "github.com/creativeprojects/go-selfupdate"
 	"github.com/pkg/browser"
 	"github.com/spf13/cobra"
 )
 
 type JiraIssue struct {
+	ID     string `json:"id"` // numeric id, needed by the dev-status API
 	Key    string `json:"key"`
 	Fields struct {
 		Summary     string `json:"summary"`
@@ -51,27 +60,74 @@ type JiraIssue struct {
 			Subtask bool   `json:"subtask"`
 		} `json:"issuetype"`
 		Parent struct {
-			Key string `json:"key"`
+			Key    string `json:"key"`
+			Fields struct {
+				Summary   string `json:"summary"`
+				IssueType struct {
+					Name           string `json:"name"`
+					HierarchyLevel int    `json:"hierarchyLevel"`
+				} `json:"issuetype"`
+			} `json:"fields"`
 		} `json:"parent"`
 		Status struct {
 			Name           string `json:"name"`
 			StatusCategory struct {
+				Key  string `json:"key"` // stable across locales: new, indeterminate, done
 				Name string `json:"name"`
 			} `json:"statusCategory"`
 		} `json:"status"`
 		Assignee struct {
+			AccountID   string `json:"accountId"`
 			DisplayName string `json:"displayName"`
 			Name        string `json:"name"`
 		} `json:"assignee"`
 		Priority struct {
 			Name string `json:"name"`
 		} `json:"priority"`
+		Updated string `json:"updated"`
 	} `json:"fields"`
+	EpicLink string `json:"-"` // classic epic link, read from epic_link_field
+	Flagged  bool   `json:"-"` // JIRA's Flagged (impediment) field is set, read from flagged_field
+}
+
+// decodeIssue decodes an issue plus the custom fields config names (EpicLinkField,
+// FlaggedField), whose ids differ between instances
+func decodeIssue(config *Config, data []byte) (JiraIssue, error) {
+	var issue JiraIssue
+	if err := json.Unmarshal(data, &issue); err != nil {
+		return JiraIssue{}, err
+	}
+	if config.EpicLinkField == "" && config.FlaggedField == "" {
+		return issue, nil
+	}
+	var raw struct {
+		Fields map[string]json.RawMessage `json:"fields"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return issue, nil
+	}
+	if v, ok := raw.Fields[config.EpicLinkField]; ok && config.EpicLinkField != "" {
+		var link string
+		if json.Unmarshal(v, &link) == nil {
+			issue.EpicLink = link
+		}
+	}
+	if v, ok := raw.Fields[config.FlaggedField]; ok && config.FlaggedField != "" {
+		// Flagged is a checkbox field: an array of options, e.g. [{"value":"Impediment"}]
+		var options []json.RawMessage
+		issue.Flagged = json.Unmarshal(v, &options) == nil && len(options) > 0
+	}
+	return issue, nil
 }
 
+// JiraResponse is one page of search results. The enhanced /search/jql endpoint pages
+// with nextPageToken/isLast; older search responses use startAt/total instead.
 type JiraResponse struct {
-	Issues []JiraIssue `json:"issues"`
-	Total  int         `json:"total"`
+	Issues        []JiraIssue `json:"issues"`
+	Total         int         `json:"total"`
+	StartAt       int         `json:"startAt"`
+	NextPageToken string      `json:"nextPageToken"`
+	IsLast        bool        `json:"isLast"`
 }
 
 type WorktreeResult struct {
@@ -82,14 +138,45 @@ type WorktreeResult struct {
 }
 
 type Config struct {
-	JiraURL         string
-	Email           string
-	APIToken        string
-	Projects        []string
-	All             bool
-	DefaultScope    string
-	EnableClaude    bool
-	EnableWorktrees bool
+	JiraURL             string
+	Email               string
+	APIToken            string
+	Projects            []string
+	All                 bool
+	AllStatuses         bool // board adds an "Other" column for unmapped status categories
+	DefaultScope        string            // project_scopes entry when one project is queried, else default_scope
+	ProjectScope        bool              // DefaultScope came from project_scopes, so it beats the board's last scope
+	DefaultProject      string            // project used when --project is not given
+	EnableClaude        bool
+	EnableWorktrees     bool
+	WorktreeBaseDir     string // configured worktree_base_dir; empty means the repo's parent dir
+	StatusCategoryNames map[string]string // statusCategory key -> instance display name
+	DefaultComponents   []string          // components applied by gci create when --component is not given
+	DefaultIssueType    string            // issue type for quick create and gci create without --type
+	PickerStatuses      []string          // statuses listed by the root issue picker; empty means statusCategory != Done
+	IncludeDone         bool              // the root picker also lists issues resolved in the last 30 days (--include-done)
+	OnDirtyTree         string            // prompt|stash|abort|ignore when switching branches with uncommitted changes
+	OnStartTransition   string            // transition applied when starting work from the board (enter/b); empty disables
+	SeparateBacklog     string            // off|todo-only|all; empty means todo-only
+	FilterKeyWeight     float64           // board filter weight for key matches; <= 0 means 1
+	FilterSummaryWeight float64           // board filter weight for summary matches; <= 0 means 1
+	ProjectTokenPaths   map[string]string // project -> 1Password token path, overriding op_jira_token_path
+	ShowEpics           bool              // board rows show each issue's epic; E groups by epic
+	EpicLinkField       string            // classic "Epic Link" custom field read from searches; set only with ShowEpics
+	FlaggedField        string            // "Flagged" custom field read from searches; empty means blocked is by status only
+	BranchStripProject  bool              // branch names start with the issue number only (branch_strip_project)
+	BranchKeyCase       string            // case of the key in branch names: upper (default) or lower
+	RefreshOnFocus      bool              // board reloads when the terminal regains focus
+	DiffCharLimit       int               // characters of diff gci create sends Claude; <= 0 means defaultDiffCharLimit
+	UnhideOnRefresh     bool              // refreshing the board shows issues hidden with x again
+	IssueTypeMarkers    map[string]string // lowercased issue type -> board row marker (defaults merged with issue_type_markers)
+	AssigneeDisplay     string            // name|badges: board rows show @firstname or colored initials
+	ColumnOverrides     map[string]string // status name -> board column title (status_column_overrides), overriding category placement
+	KeepOpen            bool              // gci returns to the picker after creating a branch (keep_open or --loop)
+	BoardLoadTimeout    time.Duration     // board_load_timeout_seconds; 0 keeps each board load's default
+	JQLPresets          map[string]string // preset name -> JQL (jql_presets), cycled on the board with p
+	PresetJQL           string            // the board's active preset query, used instead of the scope; empty when none
+	tokenPath           string            // 1Password path APIToken was read from, if any
 }
 
 var updateCheckCh <-chan version.UpdateCheckResult
@@ -97,8 +184,18 @@ var updateCheckCh <-chan version.UpdateCheckResult
 var rootCmd = &cobra.Command{
 	Use:   "gci",
 	Short: "Create Git branch from JIRA issue",
+	// main prints errors and maps them to exit codes; usage is only for flag mistakes
+	SilenceErrors: true,
 	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		cmd.SilenceUsage = true
 		logger.SetVerbose(verbose)
+		runtimeConfig := usercfg.GetRuntimeConfig()
+		httputil.SetExtraHeaders(runtimeConfig.ExtraHeaders)
+		httputil.SetTimeout(requestTimeout())
+		version.ConfigureCheck(version.CheckOptions{
+			Timeout:   updateCheckTimeout(runtimeConfig),
+			Transport: httputil.Transport(),
+		})
 
 		name := cmd.Name()
 		if name != "update" && name != "version" {
@@ -106,7 +203,7 @@ var rootCmd = &cobra.Command{
 		}
 	},
 	PersistentPostRun: func(cmd *cobra.Command, args []string) {
-		if updateCheckCh == nil {
+		if updateCheckCh == nil || quiet {
 			return
 		}
 		select {
@@ -118,16 +215,22 @@ var rootCmd = &cobra.Command{
 		case <-time.After(500 * time.Millisecond):
 		}
 	},
-	Run: runGCI,
+	RunE: runGCI,
 }
 
 var setupCmd = &cobra.Command{
 	Use:   "setup",
 	Short: "Configure GCI settings interactively",
-	Long:  "Launch a setup wizard to configure projects, boards, and default scope for GCI",
-	Run:   runSetup,
+	Long: `Launch a setup wizard to configure projects, boards, and default scope for GCI.
+
+With --projects-from-jira, projects are picked from the ones your JIRA account can
+browse instead of typed in. Without working JIRA authentication the wizard falls back
+to typing them.`,
+	Run: runSetup,
 }
 
+var setupProjectsFromJira bool
+
 // configCmd provides config management subcommands
 var configCmd = &cobra.Command{
 	Use:   "config",
@@ -142,6 +245,25 @@ var configMigrateCmd = &cobra.Command{
 	Run:   runConfigMigrate,
 }
 
+var configBackupCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Save a timestamped copy of the config file",
+	Long:  "Copy the config file into the backups directory next to it, named with the current time. 'gci config migrate' and 'gci config restore' make one automatically.",
+	Run:   runConfigBackup,
+}
+
+var configRestoreCmd = &cobra.Command{
+	Use:   "restore [file]",
+	Short: "Restore the config file from a backup",
+	Long: `Replace the config file with a backup made by 'gci config backup'. Without an
+argument, pick from the available backups, newest first. The file is checked to parse
+before anything is replaced, and the current config is backed up first.`,
+	Example: `  gci config restore
+  gci config restore config-20260301-091500.toml`,
+	Args: cobra.MaximumNArgs(1),
+	Run:  runConfigRestore,
+}
+
 var configPathCmd = &cobra.Command{
 	Use:   "path",
 	Short: "Show the path to the configuration file",
@@ -157,17 +279,26 @@ var configPrintCmd = &cobra.Command{
 }
 
 var configGetCmd = &cobra.Command{
-	Use:   "get <key>",
+	Use:   "get <key> | --all",
 	Short: "Get a configuration value",
-	Long:  "Retrieve and display a specific configuration value. Keys: projects, default_scope, jira_url, boards",
-	Args:  cobra.ExactArgs(1),
-	Run:   runConfigGet,
+	Long: `Retrieve and display a specific configuration value. Keys: projects, default_scope, project_scopes, default_project, jira_url, boards, on_dirty_tree, worktree_base_dir, picker_statuses, on_start_transition, separate_backlog, jira_email, op_jira_token_path, filter_key_weight, filter_summary_weight, show_epics, epic_link_field, flagged_field, refresh_on_focus, unhide_on_refresh, keep_open, issue_type_markers, assignee_display, status_column_overrides, board_load_timeout_seconds, diff_char_limit, branch_strip_project, branch_key_case, jql_presets
+
+With --all, print the whole effective config (defaults and environment overlays applied)
+as TOML or JSON, for backups and scripts. Token paths are included; tokens never are.`,
+	Example: "  gci config get --all --format json | jq .projects",
+	Args: func(cmd *cobra.Command, args []string) error {
+		if configGetAll {
+			return cobra.NoArgs(cmd, args)
+		}
+		return cobra.ExactArgs(1)(cmd, args)
+	},
+	Run: runConfigGet,
 }
 
 var configSetCmd = &cobra.Command{
 	Use:   "set <key> <value>",
 	Short: "Set a configuration value",
-	Long:  "Set a configuration value and save to file. Keys: default_scope, jira_url. Use 'gci setup' for projects and boards.",
+	Long:  "Set a configuration value and save to file. Keys: default_scope, project_scopes (PROJECT=SCOPE, comma-separated), default_project, jira_url, on_dirty_tree, worktree_base_dir, picker_statuses (comma-separated), on_start_transition, separate_backlog, jira_email, op_jira_token_path, filter_key_weight, filter_summary_weight, show_epics, epic_link_field, flagged_field, refresh_on_focus, unhide_on_refresh, keep_open, issue_type_markers (TYPE=MARKER, comma-separated), assignee_display, status_column_overrides, board_load_timeout_seconds, diff_char_limit, branch_strip_project, branch_key_case. Use 'gci setup' for projects and boards, and edit jql_presets in the config file.",
 	Args:  cobra.ExactArgs(2),
 	Run:   runConfigSet,
 }
@@ -175,10 +306,14 @@ var configSetCmd = &cobra.Command{
 var configDoctorCmd = &cobra.Command{
 	Use:   "doctor",
 	Short: "Check configuration health",
-	Long:  "Validate configuration file, check for common issues, and suggest fixes",
+	Long:  "Validate configuration file, check for common issues, and suggest fixes. Also checks that configured projects exist on JIRA unless --offline is given.",
 	Run:   runConfigDoctor,
 }
 
+var configDoctorOffline bool
+var configGetAll bool
+var configGetFormat string
+
 // versionCmd displays version information
 var versionCmd = &cobra.Command{
 	Use:   "version",
@@ -190,8 +325,34 @@ var versionCmd = &cobra.Command{
 var updateCmd = &cobra.Command{
 	Use:   "update",
 	Short: "Self-update gci to the latest release",
-	Long:  "Check GitHub Releases for a newer version of gci and replace the current binary.",
-	Run:   runUpdate,
+	Long: `Check GitHub Releases for a newer version of gci and replace the current binary.
+
+Use --dry-run to preview the target version, its release notes, and the binary that
+would be replaced, without changing anything.`,
+	Run: runUpdate,
+}
+
+var updateDryRun bool
+
+var installAliasCmd = &cobra.Command{
+	Use:   "install-alias",
+	Short: "Add a git alias for gci and check that gci is on PATH",
+	Long: `Configure a global git alias so "git ci" runs gci, and check whether the gci
+binary is on your PATH, printing guidance if it is not.`,
+	Example: `  gci install-alias            # git ci -> gci
+  gci install-alias --name co  # git co -> gci`,
+	Run: runInstallAlias,
+}
+
+var nameCmd = &cobra.Command{
+	Use:   "name <ISSUE-KEY>",
+	Short: "Print the branch name gci would use for an issue",
+	Long: `Fetch an issue and print the branch name gci would create for it, without
+touching git. Useful for checking how summaries turn into branch names.`,
+	Example: `  gci name INF-123
+  git checkout -b "$(gci name INF-123)"`,
+	Args: cobra.ExactArgs(1),
+	RunE: runName,
 }
 
 // boardCmd launches a TUI showing a personal Kanban view of JIRA issues
@@ -208,18 +369,36 @@ Controls:
   - /: Filter
   - o: Open selected issue in browser
   - b: Create/checkout a git branch for selected issue
-  - w: Open setup wizard
-  - q: Quit`,
-	Example: "gci board",
-	Run:     runBoard,
+  - w: Open setup wizard, then return to the board
+  - q: Quit
+
+Use --all-statuses to add an "Other" column for issues in custom status categories.
+--no-claude and --no-worktree turn off Claude and worktrees in Interactive Mode for
+this session, whatever enable_claude and enable_worktrees say.`,
+	Example: "gci board\n  gci board --all-statuses\n  gci board --no-claude --no-worktree\n  gci board --template default\n  gci board --template slack.tmpl",
+	RunE:    runBoard,
 }
 
 var (
-	allFlag     bool
-	projectFlag string
+	allFlag          bool
+	boardAllStatuses bool
+	boardTemplate    string
+	boardNoClaude    bool
+	boardNoWorktree  bool
+	loopFlag         bool
+	timeoutFlag      time.Duration
+	includeDoneFlag  bool
+	statusFlags      []string
+	projectFlag      string
 	verbose     bool
+	formatFlag  string
+	linksFlag   bool
+	emailFlag   string
+	quiet       bool
 )
 
+var aliasNameFlag string
+
 // create command flags
 var (
 	createProjectFlag string
@@ -227,6 +406,11 @@ var (
 	createNoRename    bool
 	createDryRun      bool
 	createModel       string
+	createComponents  []string
+	createNoValidate  bool
+	createDiffLimit   int
+	createTitle       string
+	createDescription string
 )
 
 var createCmd = &cobra.Command{
@@ -239,8 +423,11 @@ Useful when you've done work first and need a ticket after the fact.`,
 	Example: `  gci create                # full interactive flow
   gci create --dry-run      # preview without creating ticket
   gci create -P INF         # target a specific project
-  gci create --no-rename    # create ticket but keep current branch name`,
-	Run: runCreate,
+  gci create --component Backend --component API  # set components
+  gci create -t Bug --no-validate  # skip the issue type pre-flight check
+  gci create --no-rename    # create ticket but keep current branch name
+  gci create -P INF --title "Fix login redirect"  # no prompts, e.g. in CI`,
+	RunE: runCreate,
 }
 
 func init() {
@@ -249,29 +436,69 @@ func init() {
 	// Build the help text dynamically based on available projects (including env vars)
 	availableProjects := usercfg.GetAvailableProjectsFromRuntime()
 	projectChoices := strings.Join(availableProjects, ", ")
-	projectHelp := fmt.Sprintf("Which project to query: %s (default: both)", projectChoices)
-	rootCmd.Flags().StringVarP(&projectFlag, "project", "p", "both", projectHelp)
-	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "Enable verbose logging")
+	projectHelp := fmt.Sprintf("Which project to query: %s (default: default_project if set, else both)", projectChoices)
+	rootCmd.Flags().StringVarP(&projectFlag, "project", "p", "", projectHelp)
+	rootCmd.Flags().BoolVar(&includeDoneFlag, "include-done", false, "Also list issues resolved in the last 30 days")
+	rootCmd.Flags().StringSliceVar(&statusFlags, "status", nil, "List issues in these statuses instead of picker_statuses (repeatable or comma-separated)")
+	rootCmd.Flags().BoolVar(&loopFlag, "loop", false, "Return to the picker after creating a branch (same as keep_open)")
+	rootCmd.Flags().StringVar(&formatFlag, "format", "", "Print issues instead of opening the picker (csv or checklist, a markdown task list)")
+	rootCmd.Flags().BoolVar(&linksFlag, "links", false, "With --format checklist, link issue keys to JIRA")
+	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "Enable verbose logging (set GCI_LOG_HTTP_BODIES=1 to also log redacted HTTP bodies)")
+	rootCmd.PersistentFlags().BoolVarP(&quiet, "quiet", "q", false, "Print only results, prompts, and errors (no progress or success messages)")
+	rootCmd.PersistentFlags().DurationVar(&timeoutFlag, "timeout", 0, "Timeout for each JIRA request, e.g. 90s or 5s; lowering keeps a third of each request's own (default: GCI_TIMEOUT, else per request)")
+	rootCmd.PersistentFlags().StringVar(&emailFlag, "email", "", "JIRA account email to authenticate with (overrides jira_email and git user.email)")
 
 	// Add subcommands
 	rootCmd.AddCommand(boardCmd)
+	setupCmd.Flags().BoolVar(&setupProjectsFromJira, "projects-from-jira", false, "Pick projects from the ones JIRA lists for your account instead of typing keys")
 	rootCmd.AddCommand(setupCmd)
 	rootCmd.AddCommand(configCmd)
 	rootCmd.AddCommand(versionCmd)
+	updateCmd.Flags().BoolVar(&updateDryRun, "dry-run", false, "Show what would be installed without updating")
 	rootCmd.AddCommand(updateCmd)
 	rootCmd.AddCommand(createCmd)
+	rootCmd.AddCommand(installAliasCmd)
+	rootCmd.AddCommand(nameCmd)
+	rootCmd.AddCommand(worktreeCmd)
+	rootCmd.AddCommand(todayCmd)
+	rootCmd.AddCommand(standupCmd)
+	rootCmd.AddCommand(jqlCmd)
+	rootCmd.AddCommand(syncCmd)
+	rootCmd.AddCommand(pathsCmd)
+	boardCmd.Flags().StringVar(&boardTemplate, "template", "", "Render the board with a Go text/template file (or \"default\") and exit")
+	boardCmd.Flags().BoolVar(&boardAllStatuses, "all-statuses", false, "Add an Other column for issues outside the To Do/In Progress/Done categories")
+	boardCmd.Flags().BoolVar(&boardNoClaude, "no-claude", false, "Don't spawn Claude from Interactive Mode this session (overrides enable_claude)")
+	boardCmd.Flags().BoolVar(&boardNoWorktree, "no-worktree", false, "Create plain branches instead of worktrees this session (overrides enable_worktrees)")
+	todayCmd.Flags().BoolVar(&todayJSON, "json", false, "Print issues as JSON")
+	standupCmd.Flags().BoolVar(&standupJSON, "json", false, "Print the sections as JSON")
+	jqlCmd.Flags().StringVar(&jqlScope, "scope", "", "Scope: assigned_or_reported, assigned, reported, unassigned (default: default_scope)")
+	jqlCmd.Flags().StringVarP(&jqlProject, "project", "p", "", "Project to query, or both (default: default_project if set, else both)")
+	jqlCmd.Flags().BoolVarP(&jqlAll, "all", "a", false, "Picker query without the scope filter, like gci -a")
+	worktreeCmd.AddCommand(worktreeMigrateCmd)
+
+	installAliasCmd.Flags().StringVar(&aliasNameFlag, "name", "ci", "Git alias name (git <name> runs gci)")
 
 	// create command flags
 	createCmd.Flags().StringVarP(&createProjectFlag, "project", "P", "", "Target JIRA project (e.g. INF, CHANGE)")
-	createCmd.Flags().StringVarP(&createIssueType, "type", "t", "Task", "JIRA issue type (default: Task)")
+	createCmd.Flags().StringVarP(&createIssueType, "type", "t", "", "JIRA issue type (default: default_issue_type, else Task)")
 	createCmd.Flags().BoolVar(&createNoRename, "no-rename", false, "Create ticket without renaming the current branch")
 	createCmd.Flags().BoolVar(&createDryRun, "dry-run", false, "Preview what would be created without making changes")
+	createCmd.Flags().StringVar(&createTitle, "title", "", "Ticket title; skips the suggestion and confirmation prompts")
+	createCmd.Flags().StringVar(&createDescription, "description", "", "Ticket description with --title (default: the title)")
 	createCmd.Flags().StringVarP(&createModel, "model", "m", "haiku", "Claude model for suggestion (e.g. haiku, sonnet, opus)")
+	createCmd.Flags().BoolVar(&createNoValidate, "no-validate", false, "Skip checking the issue type against the project before creating")
+	createCmd.Flags().IntVar(&createDiffLimit, "diff-limit", 0, "Characters of diff sent to Claude (default: diff_char_limit config, else 8000)")
+	createCmd.Flags().StringArrayVar(&createComponents, "component", nil, "JIRA component for the new issue (repeatable; default: default_components config)")
 
 	// Add config subcommands
 	configCmd.AddCommand(configMigrateCmd)
+	configCmd.AddCommand(configBackupCmd)
+	configCmd.AddCommand(configRestoreCmd)
 	configCmd.AddCommand(configPathCmd)
 	configCmd.AddCommand(configPrintCmd)
+	configDoctorCmd.Flags().BoolVar(&configDoctorOffline, "offline", false, "Skip checks that contact JIRA")
+	configGetCmd.Flags().BoolVar(&configGetAll, "all", false, "Print the whole effective config")
+	configGetCmd.Flags().StringVar(&configGetFormat, "format", "toml", "Output format for --all: toml or json")
 	configCmd.AddCommand(configGetCmd)
 	configCmd.AddCommand(configSetCmd)
 	configCmd.AddCommand(configDoctorCmd)
@@ -290,38 +517,97 @@ func init() {
 
 func main() {
 	if err := rootCmd.Execute(); err != nil {
-		log.Fatal(err)
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(errors.ExitCode(err))
 	}
 }
 
-func runGCI(cmd *cobra.Command, args []string) {
+func runGCI(cmd *cobra.Command, args []string) error {
+	if formatFlag != "" && formatFlag != "csv" && formatFlag != "checklist" {
+		return fmt.Errorf("unknown --format %q (supported: csv, checklist)", formatFlag)
+	}
+	if linksFlag && formatFlag != "checklist" {
+		return fmt.Errorf("--links only applies to --format checklist")
+	}
+
+	// default_project narrows the picker and its exports when --project isn't given
+	selected, err := withDefaultProject(usercfg.GetRuntimeConfig(), projectFlag)
+	if err != nil {
+		return err
+	}
+	projectFlag = selected
+
 	config, err := loadConfig()
 	if err != nil {
-		log.Fatalf("Failed to load config: %v", err)
+		return err
+	}
+
+	// Exports aren't limited by what fits in the picker
+	maxResults := 10
+	if formatFlag != "" {
+		maxResults = 100
 	}
 
-	issues, err := fetchIssues(config)
+	issues, err := fetchIssues(config, maxResults)
 	if err != nil {
-		log.Fatalf("Failed to fetch issues: %v", err)
+		return fmt.Errorf("failed to fetch issues: %w", err)
 	}
 
-	if len(issues) == 0 {
-		fmt.Println("\033[93mNo issues found matching the criteria.\033[0m")
-		return
+	if formatFlag == "csv" {
+		if err := writeIssuesCSV(os.Stdout, config, issues); err != nil {
+			return fmt.Errorf("failed to write CSV: %w", err)
+		}
+		return nil
+	}
+	if formatFlag == "checklist" {
+		writeIssuesChecklist(os.Stdout, config, issues, linksFlag)
+		return nil
 	}
 
-	fmt.Printf("Found %d Open, Change Approved, or In Progress issue(s). (Max 10)\n", len(issues))
+	if len(issues) == 0 {
+		return errors.NewNoResultsError("No issues found matching the criteria.")
+	}
 
-	selectedIssue, err := selectIssue(issues)
-	if err != nil {
-		fmt.Println("\n\033[93mOperation cancelled by user.\033[0m")
-		return
+	notef("Found %d %s issue(s). (Max 10)\n", len(issues), pickerStatusesLabel(config))
+	if key := issueKeyFromBranch(config, getCurrentBranch()); key != "" {
+		notef("Current branch tracks %s.\n", key)
 	}
 
-	branchName := createBranchName(selectedIssue)
+	return pickAndBranch(config, issues)
+}
+
+// pickAndBranch lets the user pick an issue and creates its branch. With KeepOpen it
+// goes back to the picker over the same issues until the user quits, so a mis-pick or
+// a batch of branches costs no extra fetches.
+func pickAndBranch(config *Config, issues []JiraIssue) error {
+	created := 0
+	for {
+		selectedIssue, err := selectIssue(issues)
+		if err != nil {
+			var userErr *errors.UserError
+			if stderrors.As(err, &userErr) {
+				return err
+			}
+			if created == 0 {
+				fmt.Println("\n\033[93mOperation cancelled by user.\033[0m")
+			}
+			return nil
+		}
 
-	if err := createOrCheckoutBranch(branchName); err != nil {
-		log.Fatalf("Failed to create/checkout branch: %v", err)
+		branchName := createBranchName(config, selectedIssue)
+
+		if _, err := checkoutIssueBranch(branchName, selectedIssue.Key, config.OnDirtyTree); err != nil {
+			if !config.KeepOpen {
+				return fmt.Errorf("failed to create/checkout branch: %w", err)
+			}
+			fmt.Fprintf(os.Stderr, "\033[91mfailed to create/checkout branch: %v\033[0m\n", err)
+		} else {
+			created++
+		}
+		if !config.KeepOpen {
+			return nil
+		}
+		notef("\nPick another issue, or press Ctrl+C to quit.\n")
 	}
 }
 
@@ -331,108 +617,457 @@ func loadConfig() (*Config, error) {
 
 	// Guard: require configuration
 	if userConfig.JiraURL == "" || len(userConfig.Projects) == 0 {
-		fmt.Println("GCI is not configured yet.")
-		fmt.Println()
-		fmt.Println("Run:  gci setup")
-		fmt.Println()
-		fmt.Println("Or set environment variables:")
-		fmt.Println("  GCI_JIRA_URL=https://your-company.atlassian.net")
-		fmt.Println("  GCI_PROJECTS=PROJ1,PROJ2")
-		os.Exit(1)
+		return nil, errors.NewNotConfiguredError()
+	}
+
+	projects, err := selectProjects(userConfig, projectFlag)
+	if err != nil {
+		return nil, err
 	}
 
-	// Get email from git config
-	emailCmd := exec.Command("git", "config", "user.email")
-	emailOutput, err := emailCmd.Output()
+	// Get API token: env var > 1Password (project_token_paths, then op_jira_token_path)
+	var opErr error
+	apiToken := os.Getenv("JIRA_API_TOKEN")
+	tokenPath := tokenPathForProjects(userConfig, projects)
+
+	email, err := resolveEmail(userConfig, tokenPath)
 	if err != nil {
-		return nil, errors.NewGitConfigError(err)
+		return nil, err
+	}
+	if apiToken == "" && tokenPath != "" {
+		apiToken, opErr = readOnePasswordSecret(tokenPath)
+		if stderrors.Is(opErr, errors.ErrOnePasswordNotSignedIn) && offerOnePasswordSignin() {
+			apiToken, opErr = readOnePasswordSecret(tokenPath)
+		}
+	}
+	if apiToken == "" {
+		return nil, errors.NewOnePasswordError(opErr)
+	}
+	// Validate token if possible; only a definite 401 stops us, so flaky networks don't
+	switch checkJiraToken(userConfig.JiraURL, email, apiToken) {
+	case tokenRejected:
+		return nil, errors.NewHttpError(http.StatusUnauthorized, fmt.Sprintf("JIRA rejected the API token for %s", email))
+	case tokenUnverified:
+		logger.Config("API token validation failed, proceeding anyway")
 	}
-	email := strings.TrimSpace(string(emailOutput))
-	// Apply email domain aliases from config
-	for oldDomain, newDomain := range userConfig.EmailDomainMap {
-		email = strings.Replace(email, oldDomain, newDomain, 1)
+
+	scope, projectScoped := scopeForProjects(userConfig, projects)
+
+	// Search results carry the classic epic link only when the board shows epics, and
+	// the Flagged field when one is configured
+	epicLinkField := ""
+	if userConfig.ShowEpics {
+		epicLinkField = userConfig.EpicLinkField
 	}
 
-	// Get API token: env var > 1Password (configured path)
-	var apiToken string
-	readSecret := func(path string) string {
-		if path == "" {
-			return ""
+	return &Config{
+		JiraURL:             userConfig.JiraURL,
+		Email:               email,
+		APIToken:            apiToken,
+		Projects:            projects,
+		All:                 allFlag,
+		AllStatuses:         boardAllStatuses,
+		DefaultScope:        scope,
+		ProjectScope:        projectScoped,
+		DefaultProject:      userConfig.DefaultProject,
+		EnableClaude:        userConfig.ClaudeEnabled() && !boardNoClaude, // board flags win over config
+		EnableWorktrees:     userConfig.WorktreesEnabled() && !boardNoWorktree,
+		WorktreeBaseDir:     userConfig.WorktreeBaseDir,
+		StatusCategoryNames: userConfig.StatusCategories,
+		DefaultComponents:   userConfig.DefaultComponents,
+		DefaultIssueType:    defaultIssueType(userConfig),
+		PickerStatuses:      pickerStatuses(userConfig),
+		IncludeDone:         includeDoneFlag,
+		OnDirtyTree:         userConfig.OnDirtyTree,
+		OnStartTransition:   userConfig.OnStartTransition,
+		SeparateBacklog:     userConfig.SeparateBacklog,
+		FilterKeyWeight:     userConfig.FilterKeyWeight,
+		FilterSummaryWeight: userConfig.FilterSummaryWeight,
+		ProjectTokenPaths:   userConfig.ProjectTokenPaths,
+		ShowEpics:           userConfig.ShowEpics,
+		EpicLinkField:       epicLinkField,
+		BranchStripProject:  userConfig.BranchStripProject,
+		BranchKeyCase:       userConfig.BranchKeyCase,
+		FlaggedField:        userConfig.FlaggedField,
+		RefreshOnFocus:      userConfig.RefreshOnFocus,
+		DiffCharLimit:       userConfig.DiffCharLimit,
+		UnhideOnRefresh:     userConfig.UnhideOnRefresh,
+		IssueTypeMarkers:    issueTypeMarkers(userConfig.IssueTypeMarkers),
+		AssigneeDisplay:     userConfig.AssigneeDisplay,
+		ColumnOverrides:     userConfig.StatusColumnOverrides,
+		KeepOpen:            userConfig.KeepOpen || loopFlag,
+		BoardLoadTimeout:    time.Duration(userConfig.BoardLoadTimeoutSeconds) * time.Second,
+		JQLPresets:          userConfig.JQLPresets,
+		tokenPath:           tokenPath,
+	}, nil
+}
+
+// scopeForProjects returns the default scope for a query over projects: the
+// project_scopes entry when exactly one project is queried, else default_scope. The
+// bool reports whether a project_scopes entry was used.
+func scopeForProjects(userConfig usercfg.Config, projects []string) (string, bool) {
+	if len(projects) == 1 {
+		if scope := userConfig.ProjectScopes[projects[0]]; scope != "" {
+			return scope, true
 		}
-		out, err := exec.Command("op", "read", path).Output()
-		if err != nil {
-			logger.Config("op read failed for %s: %v", path, err)
-			return ""
+	}
+	return userConfig.DefaultScope, false
+}
+
+// withDefaultProject returns default_project when no --project was given, else
+// selected. Only the picker and gci jql narrow this way; other commands see every
+// configured project.
+func withDefaultProject(userConfig usercfg.Config, selected string) (string, error) {
+	if selected != "" || userConfig.DefaultProject == "" {
+		return selected, nil
+	}
+	if !containsString(userConfig.Projects, userConfig.DefaultProject) {
+		return "", errors.NewInvalidProjectError(userConfig.DefaultProject, userConfig.Projects)
+	}
+	return userConfig.DefaultProject, nil
+}
+
+// selectProjects resolves a --project value to the projects to query: every configured
+// project for "both" or no value, else the one named
+func selectProjects(userConfig usercfg.Config, selected string) ([]string, error) {
+	if selected == "" || selected == "both" {
+		return userConfig.Projects, nil
+	}
+
+	// Validate that the selected project is in our available list
+	availableProjects := usercfg.GetAvailableProjectsFromRuntime()
+	for _, availableProj := range availableProjects {
+		if selected == availableProj && availableProj != "both" {
+			return []string{selected}, nil
 		}
-		return strings.TrimSpace(string(out))
 	}
-	apiToken = os.Getenv("JIRA_API_TOKEN")
-	if apiToken == "" && userConfig.OPJiraTokenPath != "" {
-		apiToken = readSecret(userConfig.OPJiraTokenPath)
+	return nil, errors.NewInvalidProjectError(selected, availableProjects)
+}
+
+// notef prints progress and success messages. --quiet drops them so scripts see only
+// results, prompts, and errors.
+func notef(format string, a ...interface{}) {
+	if quiet {
+		return
 	}
-	if apiToken == "" {
-		return nil, errors.NewOnePasswordError()
+	fmt.Printf(format, a...)
+}
+
+// resolveEmail picks the JIRA auth email: --email, then jira_email, then git's
+// user.email rewritten through email_domain_map, then the username stored next to
+// the token in 1Password. Git is only consulted here, so read-only commands work
+// outside a repo; branch creation checks git's identity itself (requireGitEmail).
+func resolveEmail(userConfig usercfg.Config, tokenPath string) (string, error) {
+	for _, explicit := range []string{emailFlag, userConfig.JiraEmail} {
+		if explicit == "" {
+			continue
+		}
+		if !isEmailAddress(explicit) {
+			return "", errors.NewInvalidEmailError(explicit)
+		}
+		return explicit, nil
 	}
-	// Validate token if possible
-	if !isJiraTokenValid(userConfig.JiraURL, email, apiToken) {
-		logger.Config("API token validation failed, proceeding anyway")
+
+	email, gitErr := gitUserEmail()
+	if gitErr == nil {
+		// Apply email domain aliases from config
+		for oldDomain, newDomain := range userConfig.EmailDomainMap {
+			email = strings.Replace(email, oldDomain, newDomain, 1)
+		}
+		return email, nil
 	}
 
-	// Determine projects using user config
-	var projects []string
-	if projectFlag == "both" {
-		projects = userConfig.Projects
-	} else {
-		// Validate that the selected project is in our available list
-		availableProjects := usercfg.GetAvailableProjectsFromRuntime()
-		validProject := false
-		for _, availableProj := range availableProjects {
-			if projectFlag == availableProj && availableProj != "both" {
-				validProject = true
-				break
-			}
+	if usernamePath := onePasswordUsernamePath(tokenPath); usernamePath != "" && os.Getenv("JIRA_API_TOKEN") == "" {
+		if username, err := readOnePasswordSecret(usernamePath); err == nil && isEmailAddress(username) {
+			logger.Config("git user.email unavailable, using 1Password username from %s", usernamePath)
+			return username, nil
+		}
+	}
+	return "", errors.NewGitConfigError(gitErr)
+}
+
+// gitUserEmail returns git's configured user.email
+func gitUserEmail() (string, error) {
+	out, err := exec.Command("git", "config", "user.email").Output()
+	if err != nil {
+		return "", err
+	}
+	email := strings.TrimSpace(string(out))
+	if email == "" {
+		return "", fmt.Errorf("user.email is empty")
+	}
+	return email, nil
+}
+
+// requireGitEmail fails when git has no user.email, which gci needs before it creates
+// branches or worktrees that will carry commits
+func requireGitEmail() error {
+	if _, err := gitUserEmail(); err != nil {
+		return errors.NewGitConfigError(err)
+	}
+	return nil
+}
+
+// onePasswordUsernamePath turns a token reference like op://vault/item/credential into
+// the sibling op://vault/item/username field, or "" if path isn't an item field reference
+func onePasswordUsernamePath(path string) string {
+	if !strings.HasPrefix(path, "op://") {
+		return ""
+	}
+	parts := strings.Split(strings.TrimPrefix(path, "op://"), "/")
+	if len(parts) < 3 {
+		return ""
+	}
+	parts[len(parts)-1] = "username"
+	return "op://" + strings.Join(parts, "/")
+}
+
+// isOnePasswordPath reports whether path is a secret reference op read accepts:
+// op://vault/item/field, or op://vault/item/section/field
+func isOnePasswordPath(path string) bool {
+	rest, ok := strings.CutPrefix(path, "op://")
+	if !ok {
+		return false
+	}
+	parts := strings.Split(rest, "/")
+	if len(parts) != 3 && len(parts) != 4 {
+		return false
+	}
+	for _, part := range parts {
+		if strings.TrimSpace(part) == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// isEmailAddress reports whether s is a bare address like user@example.com
+func isEmailAddress(s string) bool {
+	addr, err := mail.ParseAddress(s)
+	return err == nil && addr.Address == s
+}
+
+// filterWeight returns a configured board filter weight, defaulting unset or
+// non-positive values to 1
+func filterWeight(w float64) float64 {
+	if w <= 0 {
+		return 1
+	}
+	return w
+}
+
+// defaultIssueType returns the configured default_issue_type, or Task
+func defaultIssueType(userConfig usercfg.Config) string {
+	if userConfig.DefaultIssueType != "" {
+		return userConfig.DefaultIssueType
+	}
+	return "Task"
+}
+
+// tokenPathForProjects picks the 1Password path for the queried projects: their
+// project_token_paths entry when they all share one, else op_jira_token_path, else the
+// first listed project's own path so a config with only per-project tokens still loads.
+func tokenPathForProjects(userConfig usercfg.Config, projects []string) string {
+	var first, firstProject string
+	sharing := true
+	for _, project := range projects {
+		path := userConfig.ProjectTokenPaths[project]
+		if path == "" || (first != "" && path != first) {
+			sharing = false
+		}
+		if first == "" && path != "" {
+			first, firstProject = path, project
+		}
+	}
+	switch {
+	case sharing && first != "":
+		return first
+	case userConfig.OPJiraTokenPath != "":
+		if len(userConfig.ProjectTokenPaths) > 0 {
+			logger.Config("projects %v do not share a project token path, using op_jira_token_path", projects)
 		}
-		if !validProject {
-			return nil, errors.NewInvalidProjectError(projectFlag, availableProjects)
+		return userConfig.OPJiraTokenPath
+	default:
+		if first != "" {
+			logger.Config("projects %v do not share a project token path and op_jira_token_path is unset, using %s's", projects, firstProject)
 		}
-		projects = []string{projectFlag}
+		return first
 	}
+}
 
-	return &Config{
-		JiraURL:         userConfig.JiraURL,
-		Email:           email,
-		APIToken:        apiToken,
-		Projects:        projects,
-		All:             allFlag,
-		DefaultScope:    userConfig.DefaultScope,
-		EnableClaude:    userConfig.ClaudeEnabled(),
-		EnableWorktrees: userConfig.WorktreesEnabled(),
-	}, nil
+// useProjectToken switches to the project's own 1Password credential when it has one
+// that differs from the token already loaded. JIRA_API_TOKEN always wins.
+func (c *Config) useProjectToken(project string) error {
+	if os.Getenv("JIRA_API_TOKEN") != "" {
+		return nil
+	}
+	path := c.ProjectTokenPaths[project]
+	if path == "" || path == c.tokenPath {
+		return nil
+	}
+	token, err := readOnePasswordSecret(path)
+	if err != nil {
+		return errors.NewOnePasswordError(err)
+	}
+	c.APIToken = token
+	c.tokenPath = path
+	return nil
 }
 
-// isJiraTokenValid checks if the given email/token can authenticate to Jira by calling /myself
-func isJiraTokenValid(jiraURL, email, token string) bool {
-	if jiraURL == "" || email == "" || token == "" {
+// readOnePasswordSecret reads a secret via `op read`, retrying once on failure since
+// the CLI occasionally fails transiently or needs the desktop app unlocked. Failures are
+// classified so callers can tell an expired session from a wrong item path.
+func readOnePasswordSecret(path string) (string, error) {
+	const attempts = 2
+	var lastErr error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		var stderr bytes.Buffer
+		args := []string{"read", path}
+		if opSession != "" {
+			args = append(args, "--session", opSession)
+		}
+		cmd := exec.Command("op", args...)
+		cmd.Stderr = &stderr
+		out, err := cmd.Output()
+		if err == nil {
+			return strings.TrimSpace(string(out)), nil
+		}
+
+		lastErr = classifyOnePasswordError(err, stderr.String())
+		logger.Config("op read attempt %d/%d failed for %s: %v", attempt, attempts, path, lastErr)
+
+		// Retrying won't make a missing item appear
+		if stderrors.Is(lastErr, errors.ErrOnePasswordItemNotFound) {
+			return "", lastErr
+		}
+		if attempt < attempts {
+			if stderrors.Is(lastErr, errors.ErrOnePasswordNotSignedIn) {
+				fmt.Fprintln(os.Stderr, "\033[93m1Password CLI is not signed in — run: op signin (retrying...)\033[0m")
+			}
+			time.Sleep(time.Second)
+		}
+	}
+	return "", lastErr
+}
+
+// opSession is the session token from an `op signin` run by gci, passed to later reads.
+// Empty when op manages the session itself (desktop app integration).
+var opSession string
+
+// opSigninOffered limits the signin prompt to once per run
+var opSigninOffered bool
+
+// offerOnePasswordSignin asks to run `op signin` after a read failed because the session
+// expired, and reports whether signin succeeded. It only asks once, and only when stdin
+// is a terminal so scripts get the plain error.
+func offerOnePasswordSignin() bool {
+	if opSigninOffered || !stdinIsTerminal() {
+		return false
+	}
+	opSigninOffered = true
+
+	signin := true
+	prompt := &survey.Confirm{Message: "1Password CLI is not signed in. Run op signin now?", Default: true}
+	if err := survey.AskOne(prompt, &signin, survey.WithStdio(os.Stdin, os.Stderr, os.Stderr)); err != nil || !signin {
+		return false
+	}
+	// --raw prints only the session token; op prompts for the password on the terminal
+	cmd := exec.Command("op", "signin", "--raw")
+	cmd.Stdin = os.Stdin
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "\033[91mop signin failed: %v\033[0m\n", err)
 		return false
 	}
+	opSession = strings.TrimSpace(string(out))
+	return true
+}
+
+// stdinIsTerminal reports whether stdin is an interactive terminal rather than a pipe or
+// file. Variable so tests can stub it.
+var stdinIsTerminal = func() bool {
+	fi, err := os.Stdin.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
+
+// verifyOnePasswordToken checks that path resolves to a non-empty secret, so setup
+// can catch a wrong item name before it is saved
+func verifyOnePasswordToken(path string) error {
+	token, err := readOnePasswordSecret(path)
+	if err != nil {
+		return err
+	}
+	if token == "" {
+		return fmt.Errorf("%s is empty", path)
+	}
+	return nil
+}
+
+// classifyOnePasswordError maps `op read` failures onto the sentinel errors in
+// internal/errors based on the CLI's stderr output.
+func classifyOnePasswordError(err error, stderr string) error {
+	msg := strings.TrimSpace(stderr)
+	lower := strings.ToLower(msg)
+	switch {
+	case strings.Contains(lower, "not currently signed in"),
+		strings.Contains(lower, "not signed in"),
+		strings.Contains(lower, "session expired"),
+		strings.Contains(lower, "authorization prompt dismissed"),
+		strings.Contains(lower, "account is locked"):
+		return fmt.Errorf("%w: %s", errors.ErrOnePasswordNotSignedIn, msg)
+	case strings.Contains(lower, "isn't an item"),
+		strings.Contains(lower, "isn't a vault"),
+		strings.Contains(lower, "isn't a field"),
+		strings.Contains(lower, "could not find"),
+		strings.Contains(lower, "not found"):
+		return fmt.Errorf("%w: %s", errors.ErrOnePasswordItemNotFound, msg)
+	}
+	if msg == "" {
+		return err
+	}
+	return fmt.Errorf("%v: %s", err, msg)
+}
+
+// tokenCheckResult is the outcome of validating credentials against /myself
+type tokenCheckResult int
+
+const (
+	tokenValid      tokenCheckResult = iota
+	tokenRejected                    // JIRA answered 401: the token or email is wrong
+	tokenUnverified                  // network error or unexpected status; validity unknown
+)
+
+// checkJiraToken checks if the given email/token can authenticate to Jira by calling /myself
+func checkJiraToken(jiraURL, email, token string) tokenCheckResult {
+	if jiraURL == "" || email == "" || token == "" {
+		return tokenUnverified
+	}
 	
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), httputil.Timeout(5*time.Second))
 	defer cancel()
 	
 	client := httputil.NewRetryableClient(5*time.Second, 1) // Quick validation, minimal retries
 	req, err := http.NewRequest("GET", fmt.Sprintf("%s/rest/api/3/myself", jiraURL), nil)
 	if err != nil {
-		return false
+		return tokenUnverified
 	}
 	req.SetBasicAuth(email, token)
 	req.Header.Set("Accept", "application/json")
 	
 	resp, err := client.DoWithRetry(ctx, req)
 	if err != nil {
-		return false
+		return tokenUnverified
 	}
 	defer resp.Body.Close()
-	return resp.StatusCode == http.StatusOK
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return tokenValid
+	case http.StatusUnauthorized:
+		return tokenRejected
+	default:
+		return tokenUnverified
+	}
 }
 
 // fetchJiraEmail calls /rest/api/3/myself and returns the account's email address.
@@ -441,7 +1076,7 @@ func fetchJiraEmail(jiraURL, authEmail, token string) (string, error) {
 		return "", fmt.Errorf("missing credentials")
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), httputil.Timeout(5*time.Second))
 	defer cancel()
 
 	client := httputil.NewRetryableClient(5*time.Second, 1)
@@ -471,86 +1106,272 @@ func fetchJiraEmail(jiraURL, authEmail, token string) (string, error) {
 	return result.EmailAddress, nil
 }
 
-func fetchIssues(config *Config) ([]JiraIssue, error) {
+// pickerJQL builds the root issue picker's query; --all drops the scope predicate
+func pickerJQL(config *Config) string {
 	// Build project filter
 	projectFilter := buildProjectFilter(config.Projects)
 
 	// Build JQL query with scope filter
-	var jql string
+	statusPredicate := pickerStatusPredicate(config)
 	if config.All {
-		jql = fmt.Sprintf("%s AND (status = Open OR status = \"In Progress\" OR status = \"Change Approved\") ORDER BY created", projectFilter)
-	} else {
-		scope := parseScopeFilter(config.DefaultScope)
-		scopePredicate := buildScopePredicate(scope)
-		jql = fmt.Sprintf("%s AND (status = Open OR status = \"In Progress\" OR status = \"Change Approved\") AND %s ORDER BY created", projectFilter, scopePredicate)
+		return fmt.Sprintf("%s AND %s ORDER BY created", projectFilter, statusPredicate)
 	}
+	scope := parseScopeFilter(config.DefaultScope)
+	scopePredicate := buildScopePredicate(scope)
+	return fmt.Sprintf("%s AND %s AND %s ORDER BY created", projectFilter, statusPredicate, scopePredicate)
+}
+
+func fetchIssues(config *Config, maxResults int) ([]JiraIssue, error) {
+	jql := pickerJQL(config)
 
 	// Make HTTP request with context and retry
-	ctx, cancel := context.WithTimeout(context.Background(), httputil.DefaultTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), httputil.Timeout(httputil.DefaultTimeout))
 	defer cancel()
 
-	client := httputil.NewDefaultClient()
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/rest/api/3/search/jql", config.JiraURL), nil)
+	// Exports always include assignee and priority
+	fields := getFieldsList(config)
+	if formatFlag != "" && !strings.Contains(fields, "assignee") {
+		fields += ",assignee,priority"
+	}
+
+	issues, err := searchJQL(ctx, config, jql, fields, maxResults)
 	if err != nil {
-		return nil, err
+		return nil, errors.WrapWithContext(err, "jira_connection")
 	}
+	return issues, nil
+}
 
-	req.SetBasicAuth(config.Email, config.APIToken)
-	req.Header.Set("Accept", "application/json")
+// searchPageSize caps each search request; JIRA returns at most 100 issues per page
+const searchPageSize = 100
 
-	q := req.URL.Query()
-	q.Add("jql", jql)
-	q.Add("maxResults", "10")
-	q.Add("fields", getFieldsList())
-	req.URL.RawQuery = q.Encode()
+// searchJQL runs jql against /rest/api/3/search/jql and collects up to maxResults
+// issues across pages. Pages are followed by nextPageToken when the response has one,
+// or by startAt when it reports startAt/total the way the older /search API does.
+func searchJQL(ctx context.Context, config *Config, jql, fields string, maxResults int) ([]JiraIssue, error) {
+	client := httputil.NewDefaultClient()
+	var issues []JiraIssue
+	pageToken := ""
+	startAt := 0
+	for len(issues) < maxResults {
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s/rest/api/3/search/jql", config.JiraURL), nil)
+		if err != nil {
+			return nil, err
+		}
+		req.SetBasicAuth(config.Email, config.APIToken)
+		req.Header.Set("Accept", "application/json")
+		q := req.URL.Query()
+		q.Add("jql", jql)
+		q.Add("maxResults", fmt.Sprintf("%d", min(searchPageSize, maxResults-len(issues))))
+		q.Add("fields", fields)
+		if pageToken != "" {
+			q.Add("nextPageToken", pageToken)
+		} else if startAt > 0 {
+			q.Add("startAt", fmt.Sprintf("%d", startAt))
+		}
+		req.URL.RawQuery = q.Encode()
 
-	var jiraResp JiraResponse
-	if err := client.DoJSONRequest(ctx, req, &jiraResp); err != nil {
-		return nil, errors.WrapWithContext(err, "jira_connection")
-	}
+		logger.HTTP("GET", req.URL.String())
 
-	return jiraResp.Issues, nil
-}
+		// Issues are decoded one by one so their custom fields can be read too
+		var page struct {
+			JiraResponse
+			Issues []json.RawMessage `json:"issues"`
+		}
+		if err := client.DoJSONRequest(ctx, req, &page); err != nil {
+			return nil, err
+		}
+		for _, raw := range page.Issues {
+			issue, err := decodeIssue(config, raw)
+			if err != nil {
+				return nil, err
+			}
+			issues = append(issues, issue)
+		}
 
-func selectIssue(issues []JiraIssue) (JiraIssue, error) {
-	var options []string
-	for _, issue := range issues {
-		options = append(options, fmt.Sprintf("%s: %s", issue.Key, issue.Fields.Summary))
+		switch {
+		case len(page.Issues) == 0 || page.IsLast:
+			return issues, nil
+		case page.NextPageToken != "":
+			pageToken = page.NextPageToken
+		case page.Total > page.StartAt+len(page.Issues):
+			startAt = page.StartAt + len(page.Issues)
+		default:
+			return issues, nil
+		}
 	}
+	return issues[:maxResults], nil
+}
 
-	prompt := &survey.Select{
-		Message: "Select an issue to create a branch for:",
-		Options: options,
+// pickerStatuses returns the root picker's statuses: --status, else picker_statuses
+func pickerStatuses(userConfig usercfg.Config) []string {
+	if len(statusFlags) > 0 {
+		return statusFlags
 	}
+	return userConfig.PickerStatuses
+}
 
-	var selectedIndex int
-	if err := survey.AskOne(prompt, &selectedIndex); err != nil {
-		return JiraIssue{}, err
+// recentlyDoneWindow is how far back --include-done reaches for resolved issues
+const recentlyDoneWindow = "-30d"
+
+// pickerStatusPredicate returns the JQL status predicate for the root issue picker:
+// the configured picker_statuses (or --status), or every status outside the Done
+// category. --include-done adds issues resolved within recentlyDoneWindow.
+func pickerStatusPredicate(config *Config) string {
+	predicate := fmt.Sprintf("statusCategory != \"%s\"", config.statusCategoryName(jira.StatusCategoryDone))
+	if len(config.PickerStatuses) > 0 {
+		var quoted []string
+		for _, status := range config.PickerStatuses {
+			quoted = append(quoted, fmt.Sprintf("\"%s\"", strings.ReplaceAll(status, `"`, `\"`)))
+		}
+		predicate = fmt.Sprintf("status in (%s)", strings.Join(quoted, ", "))
 	}
-
-	return issues[selectedIndex], nil
+	if config.IncludeDone {
+		return fmt.Sprintf("(%s OR resolved >= %s)", predicate, recentlyDoneWindow)
+	}
+	return predicate
 }
 
-func createBranchName(issue JiraIssue) string {
-	return makeBranchName(issue.Key, issue.Fields.Summary)
+// pickerStatusesLabel describes the picker's status filter for the result summary
+func pickerStatusesLabel(config *Config) string {
+	label := "unresolved"
+	if len(config.PickerStatuses) > 0 {
+		label = strings.Join(config.PickerStatuses, "/")
+	}
+	if config.IncludeDone {
+		label += " or recently resolved"
+	}
+	return label
 }
 
-// makeBranchName creates a branch name from a JIRA key and summary string
-func makeBranchName(key, summary string) string {
-	summary = strings.ToLower(summary)
-	// Replace non-alphanumeric with hyphens
-	reg := regexp.MustCompile(`[^a-z0-9]+`)
-	summary = reg.ReplaceAllString(summary, "-")
-	summary = strings.Trim(summary, "-")
-	// Truncate to reasonable length
-	if len(summary) > 50 {
-		summary = summary[:50]
-		summary = strings.TrimRight(summary, "-")
+// writeIssuesCSV writes issues as CSV with a header row. encoding/csv handles quoting
+// of summaries containing commas, quotes, or newlines.
+func writeIssuesCSV(w io.Writer, config *Config, issues []JiraIssue) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"key", "status", "assignee", "priority", "summary", "url"}); err != nil {
+		return err
 	}
-	return fmt.Sprintf("%s_%s", key, summary)
+	for _, issue := range issues {
+		record := []string{
+			issue.Key,
+			issue.Fields.Status.Name,
+			issue.Fields.Assignee.DisplayName,
+			issue.Fields.Priority.Name,
+			issue.Fields.Summary,
+			fmt.Sprintf("%s/browse/%s", config.JiraURL, issue.Key),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// writeIssuesChecklist writes issues as a markdown task list with a heading per status,
+// To Do statuses first and Done last. Done issues are checked; with links, keys link to
+// the issue in JIRA.
+func writeIssuesChecklist(w io.Writer, config *Config, issues []JiraIssue, links bool) {
+	rank := map[string]int{jira.StatusCategoryNew: 0, jira.StatusCategoryIndeterminate: 1, jira.StatusCategoryDone: 3}
+	categoryRank := func(issue JiraIssue) int {
+		if r, ok := rank[issue.Fields.Status.StatusCategory.Key]; ok {
+			return r
+		}
+		return 2
+	}
+
+	var statuses []string
+	byStatus := make(map[string][]JiraIssue)
+	for _, issue := range issues {
+		status := issue.Fields.Status.Name
+		if _, seen := byStatus[status]; !seen {
+			statuses = append(statuses, status)
+		}
+		byStatus[status] = append(byStatus[status], issue)
+	}
+	sort.SliceStable(statuses, func(i, j int) bool {
+		return categoryRank(byStatus[statuses[i]][0]) < categoryRank(byStatus[statuses[j]][0])
+	})
+
+	for i, status := range statuses {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "## %s\n\n", status)
+		for _, issue := range byStatus[status] {
+			box := "[ ]"
+			if issue.Fields.Status.StatusCategory.Key == jira.StatusCategoryDone {
+				box = "[x]"
+			}
+			key := issue.Key
+			if links {
+				key = fmt.Sprintf("[%s](%s/browse/%s)", issue.Key, config.JiraURL, issue.Key)
+			}
+			fmt.Fprintf(w, "- %s %s %s\n", box, key, issue.Fields.Summary)
+		}
+	}
+}
+
+// selectIssue asks which issue to branch for. Variable so tests can stub it.
+var selectIssue = func(issues []JiraIssue) (JiraIssue, error) {
+	if !stdinIsTerminal() {
+		return JiraIssue{}, errors.NewInteractiveInputError("which issue to branch for", "Run gci in a terminal, use gci name KEY for a branch name, or list issues with --format csv")
+	}
+	var options []string
+	for _, issue := range issues {
+		options = append(options, fmt.Sprintf("%s: %s", issue.Key, issue.Fields.Summary))
+	}
+
+	prompt := &survey.Select{
+		Message: "Select an issue to create a branch for:",
+		Options: options,
+	}
+
+	var selectedIndex int
+	if err := survey.AskOne(prompt, &selectedIndex); err != nil {
+		return JiraIssue{}, err
+	}
+
+	return issues[selectedIndex], nil
+}
+
+func createBranchName(config *Config, issue JiraIssue) string {
+	return makeBranchName(config, issue.Key, issue.Fields.Summary)
 }
 
-func createOrCheckoutWorktree(branchName string) WorktreeResult {
+// makeBranchName creates a branch name from a JIRA key and summary string
+func makeBranchName(config *Config, key, summary string) string {
+	summary = strings.ToLower(summary)
+	// Replace non-alphanumeric with hyphens
+	reg := regexp.MustCompile(`[^a-z0-9]+`)
+	summary = reg.ReplaceAllString(summary, "-")
+	summary = strings.Trim(summary, "-")
+	// Truncate to reasonable length
+	if len(summary) > 50 {
+		summary = summary[:50]
+		summary = strings.TrimRight(summary, "-")
+	}
+	return fmt.Sprintf("%s_%s", branchKey(config, key), summary)
+}
+
+// branchKey renders an issue key for a branch name: just the number with
+// branch_strip_project, lowercased with branch_key_case = lower
+func branchKey(config *Config, key string) string {
+	if config.BranchStripProject {
+		if _, number, ok := strings.Cut(key, "-"); ok {
+			key = number
+		}
+	}
+	if config.BranchKeyCase == "lower" {
+		key = strings.ToLower(key)
+	}
+	return key
+}
+
+func createOrCheckoutWorktree(branchName, baseDir, onDirtyTree string) WorktreeResult {
+	if err := requireGitEmail(); err != nil {
+		return WorktreeResult{Error: err}
+	}
+
 	// Get repository root
 	rootCmd := exec.Command("git", "rev-parse", "--show-toplevel")
 	rootOutput, err := rootCmd.Output()
@@ -560,8 +1381,11 @@ func createOrCheckoutWorktree(branchName string) WorktreeResult {
 	repoRoot := strings.TrimSpace(string(rootOutput))
 	repoName := filepath.Base(repoRoot)
 
-	// Sibling directory: ../repo-BRANCH
-	parentDir := filepath.Dir(repoRoot)
+	// BASE/repo-BRANCH, where BASE defaults to the repo's parent (a sibling directory)
+	parentDir := resolveWorktreeBaseDir(repoRoot, baseDir)
+	if err := os.MkdirAll(parentDir, 0755); err != nil {
+		return WorktreeResult{Error: fmt.Errorf("failed to create worktree base dir: %w", err)}
+	}
 	worktreePath := filepath.Join(parentDir, fmt.Sprintf("%s-%s", repoName, branchName))
 
 	// Check if worktree already exists
@@ -573,6 +1397,10 @@ func createOrCheckoutWorktree(branchName string) WorktreeResult {
 		}
 	}
 
+	if err := checkDirtyBeforeWorktree(onDirtyTree, repoRoot); err != nil {
+		return WorktreeResult{Error: err}
+	}
+
 	// Check if branch exists
 	checkCmd := exec.Command("git", "rev-parse", "--verify", branchName)
 	branchExists := checkCmd.Run() == nil
@@ -613,6 +1441,17 @@ func extractDescriptionText(issue JiraIssue) string {
 	return strings.Join(texts, "\n")
 }
 
+// printTicketContext prints the issue's key, summary, and description for starting work
+// without Claude
+func printTicketContext(issue JiraIssue) {
+	description := extractDescriptionText(issue)
+	fmt.Printf("\n\033[96m%s: %s\033[0m\n", issue.Key, issue.Fields.Summary)
+	if description != "" {
+		fmt.Printf("\n%s\n", description)
+	}
+	fmt.Println()
+}
+
 func spawnClaudeWithContext(worktreePath string, issue JiraIssue) error {
 	description := extractDescriptionText(issue)
 	prompt := fmt.Sprintf("Working on %s: %s\n\n%s",
@@ -629,53 +1468,242 @@ func spawnClaudeWithContext(worktreePath string, issue JiraIssue) error {
 	return cmd.Run()
 }
 
-func createOrCheckoutBranch(branchName string) error {
+// on_dirty_tree policies for switching to an existing branch with uncommitted changes
+const (
+	dirtyTreePrompt = "prompt" // ask whether to stash (default)
+	dirtyTreeStash  = "stash"  // auto-stash without asking
+	dirtyTreeAbort  = "abort"  // refuse to switch
+	dirtyTreeIgnore = "ignore" // attempt checkout and let git decide
+)
+
+var validDirtyTreePolicies = []string{dirtyTreePrompt, dirtyTreeStash, dirtyTreeAbort, dirtyTreeIgnore}
+
+// hasUncommittedChanges reports whether the working tree is dirty. Variable so tests can stub it.
+var hasUncommittedChanges = func() bool {
+	statusOut, _ := exec.Command("git", "status", "--porcelain").Output()
+	return len(strings.TrimSpace(string(statusOut))) > 0
+}
+
+// confirmStash asks the user whether to stash before switching. Variable so tests can stub it.
+var confirmStash = func() (bool, error) {
+	var doStash bool
+	err := survey.AskOne(&survey.Confirm{
+		Message: "Stash changes and continue?",
+		Default: true,
+	}, &doStash)
+	return doStash, err
+}
+
+// shouldStashBeforeSwitch applies the on_dirty_tree policy. It returns true when the
+// caller should stash, or an error when the switch should not proceed. With out set
+// (the board's S) notes go there and the prompt policy can't ask, so it refuses.
+func shouldStashBeforeSwitch(policy string, out io.Writer) (bool, error) {
+	if !hasUncommittedChanges() {
+		return false, nil
+	}
+
+	switch policy {
+	case dirtyTreeStash:
+		if out != nil {
+			fmt.Fprintln(out, "Uncommitted changes auto-stashed.")
+		} else {
+			fmt.Printf("\033[93mYou have uncommitted changes — auto-stashing (on_dirty_tree = stash).\033[0m\n")
+		}
+		return true, nil
+	case dirtyTreeAbort:
+		return false, fmt.Errorf("branch switch aborted: uncommitted changes (on_dirty_tree = abort)")
+	case dirtyTreeIgnore:
+		return false, nil
+	default:
+		if out != nil {
+			return false, fmt.Errorf("branch switch cancelled: uncommitted changes (commit or stash them, or set on_dirty_tree)")
+		}
+		fmt.Printf("\033[93mYou have uncommitted changes.\033[0m\n")
+		doStash, err := confirmStash()
+		if err != nil || !doStash {
+			return false, fmt.Errorf("branch switch cancelled: uncommitted changes")
+		}
+		return true, nil
+	}
+}
+
+// checkDirtyBeforeWorktree applies the on_dirty_tree policy before a new worktree is
+// created. Uncommitted changes stay in the current tree rather than following the
+// branch, so abort refuses, ignore says nothing, and otherwise the user is told where
+// the changes are.
+func checkDirtyBeforeWorktree(policy, repoRoot string) error {
+	if policy == dirtyTreeIgnore || !hasUncommittedChanges() {
+		return nil
+	}
+	if policy == dirtyTreeAbort {
+		return fmt.Errorf("worktree not created: uncommitted changes (on_dirty_tree = abort)")
+	}
+	fmt.Printf("\033[93mYou have uncommitted changes; they stay in %s and won't be in the new worktree.\033[0m\n", repoRoot)
+	return nil
+}
+
+// branchOptions controls createOrCheckoutBranch
+type branchOptions struct {
+	issueKey    string    // issue the branch is for; an existing branch is checked against it
+	onDirtyTree string    // on_dirty_tree policy for switching to an existing branch
+	takeOver    bool      // check out an existing branch even if it looks unrelated to its issue
+	out         io.Writer // where progress goes instead of stdout; when set, nothing prompts
+}
+
+// note writes a progress line to out, or in green through notef
+func (opts branchOptions) note(msg string) {
+	if opts.out != nil {
+		fmt.Fprintln(opts.out, msg)
+		return
+	}
+	notef("\033[92m%s\033[0m\n", msg)
+}
+
+// branchCollisionError is returned by createOrCheckoutBranch when branch already exists
+// but doesn't look related to its issue. The caller asks whether to take it over
+// (branchOptions.takeOver) or use alt, a free name, instead.
+type branchCollisionError struct {
+	branch string
+	alt    string
+}
+
+func (e *branchCollisionError) Error() string {
+	return fmt.Sprintf("branch %s already exists but doesn't look related to this issue", e.branch)
+}
+
+// createOrCheckoutBranch checks out branchName, creating it if needed, and returns the
+// branch it ended up on. It never asks about collisions: an existing branch that looks
+// unrelated to its issue is a *branchCollisionError unless opts.takeOver is set.
+func createOrCheckoutBranch(branchName string, opts branchOptions) (string, error) {
+	if err := requireGitEmail(); err != nil {
+		return "", err
+	}
+
 	// Check if branch already exists
-	checkCmd := exec.Command("git", "rev-parse", "--verify", branchName)
-	branchExists := checkCmd.Run() == nil
+	branchExists := gitBranchExists(branchName)
+
+	if branchExists && !opts.takeOver {
+		if opts.issueKey != "" && !branchLooksRelated(branchName, opts.issueKey) {
+			return "", &branchCollisionError{branch: branchName, alt: nextFreeBranchName(branchName, gitBranchExists)}
+		}
+	}
+	onDirtyTree := opts.onDirtyTree
 
 	// Only stash if checking out an existing branch — creating a new branch
 	// with "git checkout -b" carries uncommitted changes automatically.
 	if branchExists {
-		statusCmd := exec.Command("git", "status", "--porcelain")
-		statusOut, _ := statusCmd.Output()
-		if len(strings.TrimSpace(string(statusOut))) > 0 {
-			fmt.Printf("\033[93mYou have uncommitted changes.\033[0m\n")
-			var doStash bool
-			if err := survey.AskOne(&survey.Confirm{
-				Message: "Stash changes and continue?",
-				Default: true,
-			}, &doStash); err != nil || !doStash {
-				return fmt.Errorf("branch switch cancelled: uncommitted changes")
-			}
+		doStash, err := shouldStashBeforeSwitch(onDirtyTree, opts.out)
+		if err != nil {
+			return "", err
+		}
+		if doStash {
 			stashCmd := exec.Command("git", "stash", "push", "-m", fmt.Sprintf("gci: auto-stash before switching to %s", branchName))
 			if out, err := stashCmd.CombinedOutput(); err != nil {
-				return fmt.Errorf("git stash failed: %s", strings.TrimSpace(string(out)))
+				return "", fmt.Errorf("git stash failed: %s", strings.TrimSpace(string(out)))
 			}
-			fmt.Printf("\033[92mChanges stashed.\033[0m\n")
+			opts.note("Changes stashed.")
 		}
 
-		fmt.Printf("\033[92mBranch \"%s\" already exists. Checking out the branch.\033[0m\n", branchName)
+		opts.note(fmt.Sprintf("Branch \"%s\" already exists. Checking out the branch.", branchName))
 		checkoutCmd := exec.Command("git", "checkout", branchName)
 		if out, err := checkoutCmd.CombinedOutput(); err != nil {
-			return fmt.Errorf("git checkout failed: %s", strings.TrimSpace(string(out)))
+			return "", fmt.Errorf("git checkout failed: %s", strings.TrimSpace(string(out)))
 		}
-		return nil
+		return branchName, nil
 	}
 
 	// Branch doesn't exist — create and checkout (uncommitted changes carry over)
-	fmt.Printf("\033[92mCreating and checking out branch \"%s\".\033[0m\n", branchName)
+	opts.note(fmt.Sprintf("Creating and checking out branch \"%s\".", branchName))
 	createCmd := exec.Command("git", "checkout", "-b", branchName)
 	if out, err := createCmd.CombinedOutput(); err != nil {
-		return fmt.Errorf("git checkout -b failed: %s", strings.TrimSpace(string(out)))
+		return "", fmt.Errorf("git checkout -b failed: %s", strings.TrimSpace(string(out)))
 	}
-	return nil
+	return branchName, nil
+}
+
+// checkoutIssueBranch is createOrCheckoutBranch for the command line: when the branch
+// exists but looks unrelated it asks whether to take it over or create a free name.
+// Without a terminal it refuses rather than guess, naming the free branch.
+func checkoutIssueBranch(branchName, issueKey, onDirtyTree string) (string, error) {
+	branch, err := createOrCheckoutBranch(branchName, branchOptions{issueKey: issueKey, onDirtyTree: onDirtyTree})
+	var collision *branchCollisionError
+	if !stderrors.As(err, &collision) {
+		return branch, err
+	}
+	if !stdinIsTerminal() {
+		return "", errors.NewBranchCollisionError(collision.branch, collision.alt, collision)
+	}
+	useExisting, err := confirmBranchCollision(collision.branch, collision.alt)
+	if err != nil {
+		return "", fmt.Errorf("branch switch cancelled: %s already exists", collision.branch)
+	}
+	if !useExisting {
+		branchName = collision.alt
+	}
+	return createOrCheckoutBranch(branchName, branchOptions{onDirtyTree: onDirtyTree, takeOver: true})
+}
+
+// gitBranchExists reports whether name resolves to a commit
+func gitBranchExists(name string) bool {
+	return exec.Command("git", "rev-parse", "--verify", "--quiet", name).Run() == nil
+}
+
+// nextFreeBranchName returns name-2, name-3, ... whichever doesn't exist yet
+func nextFreeBranchName(name string, exists func(string) bool) string {
+	for n := 2; ; n++ {
+		candidate := fmt.Sprintf("%s-%d", name, n)
+		if !exists(candidate) {
+			return candidate
+		}
+	}
+}
+
+// branchLooksRelated reports whether an existing branch appears to be work on key: it
+// has no commits of its own yet, one of its own commits mentions the key, or its
+// upstream does. A branch failing all three was likely made by hand under the same
+// name. Variable so tests can stub it.
+var branchLooksRelated = func(branch, key string) bool {
+	if upstream, err := exec.Command("git", "rev-parse", "--abbrev-ref", branch+"@{upstream}").Output(); err == nil && strings.Contains(string(upstream), key) {
+		return true
+	}
+	out, err := exec.Command("git", "log", "--format=%s%n%b", "HEAD.."+branch).Output()
+	if err != nil {
+		return true // can't tell; keep the old behavior of checking it out
+	}
+	messages := strings.TrimSpace(string(out))
+	return messages == "" || strings.Contains(messages, key)
+}
+
+// confirmBranchCollision asks whether to check out an existing branch that doesn't look
+// related to its issue, or create alt instead. Variable so tests can stub it.
+var confirmBranchCollision = func(branch, alt string) (bool, error) {
+	checkout := "Check out " + branch
+	var choice string
+	err := survey.AskOne(&survey.Select{
+		Message: fmt.Sprintf("Branch %s already exists but doesn't look related to this issue. Check it out, or create %s?", branch, alt),
+		Options: []string{checkout, "Create " + alt},
+	}, &choice)
+	return choice == checkout, err
+}
+
+// issueURL returns the browse URL for an issue key
+func issueURL(config *Config, key string) string {
+	return fmt.Sprintf("%s/browse/%s", config.JiraURL, key)
 }
 
 // openIssueInBrowser opens the selected issue in the default browser
 func openIssueInBrowser(config *Config, issue JiraIssue) error {
-	url := fmt.Sprintf("%s/browse/%s", config.JiraURL, issue.Key)
-	return browser.OpenURL(url)
+	return browser.OpenURL(issueURL(config, issue.Key))
+}
+
+// issueSearchURL returns the issue navigator URL for a JQL query
+func issueSearchURL(config *Config, jql string) string {
+	return fmt.Sprintf("%s/issues/?jql=%s", config.JiraURL, url.QueryEscape(jql))
+}
+
+// openSearchInBrowser opens a JQL query in JIRA's issue navigator
+func openSearchInBrowser(config *Config, jql string) error {
+	return browser.OpenURL(issueSearchURL(config, jql))
 }
 
 // ---- gci create: retroactive ticket creation ----
@@ -697,6 +1725,7 @@ type createIssueFields struct {
 	IssueType issueTypeRef `json:"issuetype"`
 	Assignee  *assigneeRef `json:"assignee,omitempty"`
 	Description *adfDocument `json:"description,omitempty"`
+	Components  []componentRef `json:"components,omitempty"`
 }
 
 type projectRef struct {
@@ -711,6 +1740,10 @@ type assigneeRef struct {
 	AccountID string `json:"accountId"`
 }
 
+type componentRef struct {
+	Name string `json:"name"`
+}
+
 type adfDocument struct {
 	Type    string     `json:"type"`
 	Version int        `json:"version"`
@@ -742,6 +1775,31 @@ func getCurrentBranch() string {
 	return strings.TrimSpace(string(out))
 }
 
+// issueKeyPattern matches an issue key at the start of a branch name, as created by gci
+var issueKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9]*-\d+`)
+
+// issueKeyFromBranch returns the issue key a branch name starts with, or "". With
+// branch_key_case = lower, lowercase keys are recognized too; branches named with
+// branch_strip_project carry no project and never match.
+func issueKeyFromBranch(config *Config, branch string) string {
+	if config.BranchKeyCase == "lower" {
+		branch = strings.ToUpper(branch)
+	}
+	return issueKeyPattern.FindString(branch)
+}
+
+// branchAlreadyMatches reports whether branch already names the issue: the same key and
+// summary words as makeBranchName(config, key, title), however they were cased or separated.
+// Renaming such a branch would be a no-op or churn.
+func branchAlreadyMatches(config *Config, branch, key, title string) bool {
+	prefix := branchKey(config, key)
+	rest, ok := strings.CutPrefix(branch, prefix)
+	if !ok || (rest != "" && rest[0] >= '0' && rest[0] <= '9') {
+		return false
+	}
+	return makeBranchName(config, key, rest) == makeBranchName(config, key, title)
+}
+
 // isProtectedBranch returns true for branches that should not be renamed
 func isProtectedBranch(branch string) bool {
 	switch branch {
@@ -752,45 +1810,165 @@ func isProtectedBranch(branch string) bool {
 	}
 }
 
-// captureGitDiff auto-detects and captures the relevant diff for ticket generation
-func captureGitDiff() (string, error) {
-	var diffParts []string
+// defaultDiffCharLimit is how much of the diff gci create sends Claude when neither
+// --diff-limit nor diff_char_limit is set
+const defaultDiffCharLimit = 8000
+
+// lockfiles are generated dependency manifests whose diffs say little about the change
+var lockfiles = map[string]bool{
+	"package-lock.json": true,
+	"yarn.lock":         true,
+	"pnpm-lock.yaml":    true,
+	"go.sum":            true,
+	"Cargo.lock":        true,
+	"poetry.lock":       true,
+	"Gemfile.lock":      true,
+	"composer.lock":     true,
+	"Pipfile.lock":      true,
+}
+
+// captureGitDiff auto-detects and captures the relevant diff for ticket generation,
+// fitted into limit characters by fitDiff
+func captureGitDiff(limit int) (string, error) {
+	var diff, stat string
 
 	// 1. Check for uncommitted changes (staged + unstaged)
+	rangeArgs := []string{"HEAD"}
 	cmd := exec.Command("git", "diff", "HEAD")
 	out, err := cmd.Output()
 	if err == nil && len(strings.TrimSpace(string(out))) > 0 {
-		diffParts = append(diffParts, string(out))
+		diff = string(out)
 	}
 
 	// 2. If no uncommitted changes, get commits since main
-	if len(diffParts) == 0 {
+	if diff == "" {
+		rangeArgs = []string{"main...HEAD"}
 		cmd = exec.Command("git", "diff", "main...HEAD")
 		out, err = cmd.Output()
 		if err == nil && len(strings.TrimSpace(string(out))) > 0 {
-			diffParts = append(diffParts, string(out))
+			diff = string(out)
+		}
+	}
+	if diff != "" {
+		if out, err := exec.Command("git", append([]string{"diff", "--stat"}, rangeArgs...)...).Output(); err == nil {
+			stat = string(out)
 		}
 	}
 
-	// 3. Append untracked file names
+	// 3. Untracked file names
+	var untracked string
 	cmd = exec.Command("git", "ls-files", "--others", "--exclude-standard")
 	out, err = cmd.Output()
 	if err == nil && len(strings.TrimSpace(string(out))) > 0 {
-		diffParts = append(diffParts, "Untracked files:\n"+string(out))
+		untracked = "Untracked files:\n" + string(out)
 	}
 
-	if len(diffParts) == 0 {
+	if diff == "" && untracked == "" {
 		return "", fmt.Errorf("no changes detected (clean tree with no branch commits)")
 	}
 
-	result := strings.Join(diffParts, "\n")
+	result := fitDiff(stat, diff, limit)
+	if untracked != "" {
+		if result != "" {
+			result += "\n"
+		}
+		result += untracked
+	}
+	if len(result) > limit {
+		result = truncateAtLine(result, limit) + "\n... [truncated]"
+	}
+	return result, nil
+}
 
-	// Truncate to 8000 chars if needed
-	if len(result) > 8000 {
-		result = result[:8000] + "\n... [truncated]"
+// fitDiff builds the diff text sent to Claude within limit characters: the --stat
+// summary first, then whole file diffs in order. A file that doesn't fit contributes
+// as many complete hunks as fit. Lockfiles and binary files are left out, and every
+// file dropped or cut short is named at the end.
+func fitDiff(stat, diff string, limit int) string {
+	var b strings.Builder
+	if stat != "" {
+		b.WriteString(stat)
+		if !strings.HasSuffix(stat, "\n") {
+			b.WriteString("\n")
+		}
 	}
 
-	return result, nil
+	var omitted []string
+	for _, file := range splitFileDiffs(diff) {
+		name := fileDiffName(file)
+		switch {
+		case lockfiles[filepath.Base(name)]:
+			omitted = append(omitted, name+" (lockfile)")
+			continue
+		case strings.Contains(file, "\nBinary files ") || strings.Contains(file, "\nGIT binary patch"):
+			omitted = append(omitted, name+" (binary)")
+			continue
+		}
+		remaining := limit - b.Len()
+		if len(file) <= remaining {
+			b.WriteString(file)
+			continue
+		}
+		hunks := strings.Split(file, "\n@@")
+		if len(hunks) < 2 || len(hunks[0])+len(hunks[1])+3 > remaining {
+			omitted = append(omitted, name+" (too large)")
+			continue
+		}
+		part := hunks[0]
+		kept := 0
+		for _, hunk := range hunks[1:] {
+			if len(part)+len(hunk)+3 > remaining {
+				break
+			}
+			part += "\n@@" + hunk
+			kept++
+		}
+		b.WriteString(strings.TrimSuffix(part, "\n") + "\n")
+		omitted = append(omitted, fmt.Sprintf("%s (%d of %d hunks)", name, kept, len(hunks)-1))
+	}
+	if len(omitted) > 0 {
+		b.WriteString("Omitted from diff: " + strings.Join(omitted, ", ") + "\n")
+	}
+	return b.String()
+}
+
+// splitFileDiffs splits git diff output into one chunk per file, each starting with
+// its "diff --git" line
+func splitFileDiffs(diff string) []string {
+	var files []string
+	for _, part := range strings.SplitAfter(diff, "\n") {
+		if strings.HasPrefix(part, "diff --git ") || len(files) == 0 {
+			files = append(files, part)
+			continue
+		}
+		files[len(files)-1] += part
+	}
+	if len(files) == 1 && strings.TrimSpace(files[0]) == "" {
+		return nil
+	}
+	return files
+}
+
+// fileDiffName returns the path a file diff applies to, from its "diff --git a/x b/x" line
+func fileDiffName(file string) string {
+	header, _, _ := strings.Cut(file, "\n")
+	if i := strings.LastIndex(header, " b/"); i >= 0 {
+		return header[i+3:]
+	}
+	return strings.TrimPrefix(header, "diff --git ")
+}
+
+// truncateAtLine cuts s to at most limit characters, ending at a line break when there
+// is one
+func truncateAtLine(s string, limit int) string {
+	if len(s) <= limit {
+		return s
+	}
+	s = s[:limit]
+	if i := strings.LastIndex(s, "\n"); i > 0 {
+		s = s[:i]
+	}
+	return s
 }
 
 // renameBranch renames the current branch to newName
@@ -804,8 +1982,9 @@ func renameBranch(newName string) error {
 	return nil
 }
 
-// generateTicketSuggestion uses Claude to analyze the diff and suggest a ticket
-func generateTicketSuggestion(diff string, model string) (ticketSuggestion, error) {
+// generateTicketSuggestion uses Claude to analyze the diff and suggest a ticket.
+// related, if set, names an existing ticket the branch already references.
+func generateTicketSuggestion(diff, model, related string) (ticketSuggestion, error) {
 	// Check if claude is available
 	if _, err := exec.LookPath("claude"); err != nil {
 		fmt.Println("\033[93mclaude not found in PATH — falling back to manual entry\033[0m")
@@ -819,6 +1998,9 @@ DESCRIPTION: <a one-sentence description of what the change does>
 Do not include any other text, markdown, or formatting. Just the two lines.
 
 %s`, diff)
+	if related != "" {
+		prompt = fmt.Sprintf("This branch already references the existing ticket %s. Suggest a separate ticket for this work, not a duplicate of it.\n\n%s", related, prompt)
+	}
 
 	args := []string{"-p", prompt}
 	if model != "" {
@@ -830,6 +2012,11 @@ Do not include any other text, markdown, or formatting. Just the two lines.
 	cmd.Stderr = &stderr
 
 	if err := cmd.Run(); err != nil {
+		if model != "" && isUnknownModelError(stderr.String()+stdout.String()) {
+			fmt.Printf("\033[93mClaude doesn't recognize --model %q. Use %s, or a full model id — falling back to manual entry\033[0m\n",
+				model, strings.Join(claudeModelAliases, ", "))
+			return manualTicketEntry()
+		}
 		fmt.Printf("\033[93mClaude failed (%v) — falling back to manual entry\033[0m\n", err)
 		return manualTicketEntry()
 	}
@@ -844,6 +2031,24 @@ Do not include any other text, markdown, or formatting. Just the two lines.
 	return suggestion, nil
 }
 
+// claudeModelAliases are the model names the claude CLI accepts besides full model ids
+var claudeModelAliases = []string{"haiku", "sonnet", "opus"}
+
+// isUnknownModelError reports whether claude's output says the --model value was
+// rejected, as opposed to an auth, network, or usage failure
+func isUnknownModelError(output string) bool {
+	lower := strings.ToLower(output)
+	if !strings.Contains(lower, "model") {
+		return false
+	}
+	for _, marker := range []string{"not_found_error", "not found", "invalid model", "unknown model", "does not exist", "may not exist", "not available"} {
+		if strings.Contains(lower, marker) {
+			return true
+		}
+	}
+	return false
+}
+
 // parseTicketSuggestion extracts title and description from Claude's output
 func parseTicketSuggestion(output string) (ticketSuggestion, error) {
 	var s ticketSuggestion
@@ -866,6 +2071,12 @@ func parseTicketSuggestion(output string) (ticketSuggestion, error) {
 
 // manualTicketEntry prompts the user to type title and description manually
 func manualTicketEntry() (ticketSuggestion, error) {
+	if createTitle != "" {
+		return ticketFromFlags(), nil
+	}
+	if !stdinIsTerminal() {
+		return ticketSuggestion{}, errors.NewInteractiveInputError("the ticket title", "Pass --title (and --description)")
+	}
 	var s ticketSuggestion
 	if err := survey.AskOne(&survey.Input{Message: "Ticket title:"}, &s.Title, survey.WithValidator(survey.Required)); err != nil {
 		return s, err
@@ -876,10 +2087,27 @@ func manualTicketEntry() (ticketSuggestion, error) {
 	return s, nil
 }
 
-// confirmTicketDetails displays the suggestion and lets the user edit or accept it
+// ticketFromFlags builds the ticket from --title and --description
+func ticketFromFlags() ticketSuggestion {
+	description := createDescription
+	if description == "" {
+		description = createTitle
+	}
+	return ticketSuggestion{Title: createTitle, Description: description}
+}
+
+// confirmTicketDetails displays the suggestion and lets the user edit or accept it.
+// Tickets from --title, and any ticket without a terminal to ask on, are used as-is.
 func confirmTicketDetails(suggestion ticketSuggestion) (string, string, error) {
 	fmt.Printf("\n  Title:       %s\n", suggestion.Title)
 	fmt.Printf("  Description: %s\n\n", suggestion.Description)
+	if createTitle != "" {
+		return suggestion.Title, suggestion.Description, nil
+	}
+	// Never create an issue from an unreviewed suggestion
+	if !stdinIsTerminal() {
+		return "", "", errors.NewInteractiveInputError("confirmation of the suggested ticket", "Pass --title (and --description) to create without prompts")
+	}
 
 	var choice string
 	if err := survey.AskOne(&survey.Select{
@@ -913,53 +2141,284 @@ func confirmTicketDetails(suggestion ticketSuggestion) (string, string, error) {
 	return title, description, nil
 }
 
-// resolveTargetProject determines which JIRA project to use
+// resolveTargetProject determines which JIRA project to use. Choices come from the
+// same runtime project list as the root --project flag, so GCI_PROJECTS applies here too.
 func resolveTargetProject(config *Config) (string, error) {
+	available := usercfg.GetRuntimeProjects()
+
 	// Flag takes priority
 	if createProjectFlag != "" {
+		if !containsString(available, createProjectFlag) {
+			return "", errors.NewInvalidProjectError(createProjectFlag, available)
+		}
 		return createProjectFlag, nil
 	}
 
-	// Single project — use it
-	if len(config.Projects) == 1 {
-		return config.Projects[0], nil
+	// Single project — use it
+	if len(available) == 1 {
+		return available[0], nil
+	}
+
+	// Configured default project — use it
+	if config.DefaultProject != "" && containsString(available, config.DefaultProject) {
+		return config.DefaultProject, nil
+	}
+
+	// Multiple projects — prompt
+	if !stdinIsTerminal() {
+		return "", errors.NewInteractiveInputError("the target project", fmt.Sprintf("Pass --project (one of %s) or set default_project", strings.Join(available, ", ")))
+	}
+	var project string
+	if err := survey.AskOne(&survey.Select{
+		Message: "Which project?",
+		Options: available,
+	}, &project); err != nil {
+		return "", err
+	}
+	return project, nil
+}
+
+// getMyAccountId fetches the current user's JIRA account ID
+func getMyAccountId(config *Config) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), httputil.Timeout(httputil.DefaultTimeout))
+	defer cancel()
+
+	client := httputil.NewDefaultClient()
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/rest/api/3/myself", config.JiraURL), nil)
+	if err != nil {
+		return "", err
+	}
+	req.SetBasicAuth(config.Email, config.APIToken)
+	req.Header.Set("Accept", "application/json")
+
+	var result struct {
+		AccountID string `json:"accountId"`
+	}
+	if err := client.DoJSONRequest(ctx, req, &result); err != nil {
+		return "", fmt.Errorf("failed to fetch JIRA account: %w", err)
+	}
+	return result.AccountID, nil
+}
+
+// jiraUser is a match from the user search endpoint
+type jiraUser struct {
+	AccountID    string `json:"accountId"`
+	DisplayName  string `json:"displayName"`
+	EmailAddress string `json:"emailAddress"`
+}
+
+// userSearchCache holds user search results per JIRA URL and query for this run, so
+// repeated reassignments to the same people don't hit JIRA again
+var (
+	userSearchCache   = map[string][]jiraUser{}
+	userSearchCacheMu sync.Mutex
+)
+
+// searchUsers finds active JIRA users matching a name or email fragment
+func searchUsers(config *Config, query string) ([]jiraUser, error) {
+	cacheKey := config.JiraURL + "|" + strings.ToLower(query)
+	userSearchCacheMu.Lock()
+	users, ok := userSearchCache[cacheKey]
+	userSearchCacheMu.Unlock()
+	if ok {
+		return users, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), httputil.Timeout(httputil.DefaultTimeout))
+	defer cancel()
+
+	client := httputil.NewDefaultClient()
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/rest/api/3/user/search", config.JiraURL), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(config.Email, config.APIToken)
+	req.Header.Set("Accept", "application/json")
+	q := req.URL.Query()
+	q.Add("query", query)
+	q.Add("maxResults", "10")
+	req.URL.RawQuery = q.Encode()
+
+	logger.HTTP("GET", req.URL.String())
+
+	if err := client.DoJSONRequest(ctx, req, &users); err != nil {
+		return nil, fmt.Errorf("failed to search users: %w", err)
+	}
+	userSearchCacheMu.Lock()
+	userSearchCache[cacheKey] = users
+	userSearchCacheMu.Unlock()
+	return users, nil
+}
+
+// createMetaIssueType is an issue type that can be created in a project
+type createMetaIssueType struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// issueTypesCache holds createmeta issue types per JIRA URL and project for this run
+var issueTypesCache = map[string][]createMetaIssueType{}
+
+// fetchProjectIssueTypes returns the issue types that can be created in a project,
+// caching the result so validation and component lookups share one request.
+func fetchProjectIssueTypes(config *Config, project string) ([]createMetaIssueType, error) {
+	cacheKey := config.JiraURL + "|" + project
+	if types, ok := issueTypesCache[cacheKey]; ok {
+		return types, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), httputil.Timeout(httputil.DefaultTimeout))
+	defer cancel()
+
+	client := httputil.NewDefaultClient()
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/rest/api/3/issue/createmeta/%s/issuetypes", config.JiraURL, project), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(config.Email, config.APIToken)
+	req.Header.Set("Accept", "application/json")
+
+	var typesResp struct {
+		IssueTypes []createMetaIssueType `json:"issueTypes"`
+	}
+	if err := client.DoJSONRequest(ctx, req, &typesResp); err != nil {
+		return nil, fmt.Errorf("failed to fetch issue types for %s: %w", project, err)
+	}
+	issueTypesCache[cacheKey] = typesResp.IssueTypes
+	return typesResp.IssueTypes, nil
+}
+
+// validateIssueType checks the issue type against the project's createmeta and returns
+// its canonical name. An unknown type prompts for a valid one; if the prompt is cancelled
+// or unavailable, the error lists the valid types. Lookup failures are not fatal.
+func validateIssueType(config *Config, project, issueType string) (string, error) {
+	types, err := fetchProjectIssueTypes(config, project)
+	if err != nil {
+		logger.JIRA("issue type pre-flight skipped: %v", err)
+		return issueType, nil
+	}
+
+	var names []string
+	for _, it := range types {
+		if strings.EqualFold(it.Name, issueType) {
+			return it.Name, nil
+		}
+		names = append(names, it.Name)
+	}
+	if len(names) == 0 {
+		return issueType, nil
+	}
+
+	fmt.Printf("\033[93mIssue type %q is not available in %s.\033[0m\n", issueType, project)
+	var selected string
+	if err := survey.AskOne(&survey.Select{
+		Message: "Choose an issue type:",
+		Options: names,
+	}, &selected); err != nil {
+		return "", fmt.Errorf("issue type %q is not valid for %s (valid types: %s)", issueType, project, strings.Join(names, ", "))
+	}
+	return selected, nil
+}
+
+// componentMeta describes the components field for a project/issue type, from createmeta
+type componentMeta struct {
+	Required bool
+	Allowed  []string
+}
+
+// fetchComponentMeta asks JIRA's createmeta whether components are required for the
+// given project and issue type, and which components are valid.
+func fetchComponentMeta(config *Config, project, issueType string) (componentMeta, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), httputil.Timeout(httputil.DefaultTimeout))
+	defer cancel()
+
+	client := httputil.NewDefaultClient()
+
+	// Resolve the issue type name to its ID
+	types, err := fetchProjectIssueTypes(config, project)
+	if err != nil {
+		return componentMeta{}, err
+	}
+	var issueTypeID string
+	for _, it := range types {
+		if strings.EqualFold(it.Name, issueType) {
+			issueTypeID = it.ID
+			break
+		}
+	}
+	if issueTypeID == "" {
+		return componentMeta{}, fmt.Errorf("issue type %q not found in project %s", issueType, project)
+	}
+
+	// Fetch field metadata for that issue type
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/rest/api/3/issue/createmeta/%s/issuetypes/%s", config.JiraURL, project, issueTypeID), nil)
+	if err != nil {
+		return componentMeta{}, err
 	}
+	req.SetBasicAuth(config.Email, config.APIToken)
+	req.Header.Set("Accept", "application/json")
 
-	// Multiple projects — prompt
-	var project string
-	if err := survey.AskOne(&survey.Select{
-		Message: "Which project?",
-		Options: config.Projects,
-	}, &project); err != nil {
-		return "", err
+	var fieldsResp struct {
+		Fields []struct {
+			FieldID       string `json:"fieldId"`
+			Required      bool   `json:"required"`
+			AllowedValues []struct {
+				Name string `json:"name"`
+			} `json:"allowedValues"`
+		} `json:"fields"`
 	}
-	return project, nil
+	if err := client.DoJSONRequest(ctx, req, &fieldsResp); err != nil {
+		return componentMeta{}, fmt.Errorf("failed to fetch create metadata for %s: %w", project, err)
+	}
+
+	var meta componentMeta
+	for _, f := range fieldsResp.Fields {
+		if f.FieldID != "components" {
+			continue
+		}
+		meta.Required = f.Required
+		for _, v := range f.AllowedValues {
+			meta.Allowed = append(meta.Allowed, v.Name)
+		}
+	}
+	return meta, nil
 }
 
-// getMyAccountId fetches the current user's JIRA account ID
-func getMyAccountId(config *Config) (string, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), httputil.DefaultTimeout)
-	defer cancel()
+// resolveComponents determines the components for a new issue: --component flags, then
+// default_components config, then a prompt if the project requires components.
+func resolveComponents(config *Config, project, issueType string) ([]string, error) {
+	if len(createComponents) > 0 {
+		return createComponents, nil
+	}
+	if len(config.DefaultComponents) > 0 {
+		return config.DefaultComponents, nil
+	}
 
-	client := httputil.NewDefaultClient()
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/rest/api/3/myself", config.JiraURL), nil)
+	meta, err := fetchComponentMeta(config, project, issueType)
 	if err != nil {
-		return "", err
+		// Let JIRA report a missing required field rather than blocking here
+		logger.JIRA("component metadata lookup failed: %v", err)
+		return nil, nil
+	}
+	if !meta.Required || len(meta.Allowed) == 0 {
+		return nil, nil
 	}
-	req.SetBasicAuth(config.Email, config.APIToken)
-	req.Header.Set("Accept", "application/json")
 
-	var result struct {
-		AccountID string `json:"accountId"`
+	if !stdinIsTerminal() {
+		return nil, errors.NewInteractiveInputError(project+"'s required component", "Pass --component (repeatable) or set default_components")
 	}
-	if err := client.DoJSONRequest(ctx, req, &result); err != nil {
-		return "", fmt.Errorf("failed to fetch JIRA account: %w", err)
+	var selected []string
+	if err := survey.AskOne(&survey.MultiSelect{
+		Message: fmt.Sprintf("%s requires a component. Select component(s):", project),
+		Options: meta.Allowed,
+	}, &selected, survey.WithValidator(survey.Required)); err != nil {
+		return nil, err
 	}
-	return result.AccountID, nil
+	return selected, nil
 }
 
 // createJiraIssue creates a new JIRA issue and returns the issue key
-func createJiraIssue(config *Config, project, title, description, issueType, accountId string) (string, error) {
+func createJiraIssue(config *Config, project, title, description, issueType, accountId string, components []string) (string, error) {
 	// Build ADF description
 	var desc *adfDocument
 	if description != "" {
@@ -977,6 +2436,11 @@ func createJiraIssue(config *Config, project, title, description, issueType, acc
 		}
 	}
 
+	var componentRefs []componentRef
+	for _, name := range components {
+		componentRefs = append(componentRefs, componentRef{Name: name})
+	}
+
 	body := createIssueRequest{
 		Fields: createIssueFields{
 			Project:     projectRef{Key: project},
@@ -984,6 +2448,7 @@ func createJiraIssue(config *Config, project, title, description, issueType, acc
 			IssueType:   issueTypeRef{Name: issueType},
 			Assignee:    &assigneeRef{AccountID: accountId},
 			Description: desc,
+			Components:  componentRefs,
 		},
 	}
 
@@ -992,7 +2457,7 @@ func createJiraIssue(config *Config, project, title, description, issueType, acc
 		return "", fmt.Errorf("failed to marshal request: %w", err)
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), httputil.DefaultTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), httputil.Timeout(httputil.DefaultTimeout))
 	defer cancel()
 
 	client := httputil.NewDefaultClient()
@@ -1025,22 +2490,123 @@ func createJiraIssue(config *Config, project, title, description, issueType, acc
 	return issueResp.Key, nil
 }
 
+// isPromptCancel reports whether err is the user backing out of a prompt (Ctrl+C)
+func isPromptCancel(err error) bool {
+	return stderrors.Is(err, terminal.InterruptErr)
+}
+
+// offerExistingIssue asks what to do when the current branch already tracks issue:
+// open it, transition it, or create a new ticket anyway. It reports whether to go on
+// creating.
+func offerExistingIssue(config *Config, issue JiraIssue) (bool, error) {
+	fmt.Printf("This branch already tracks \033[96m%s\033[0m: %s [%s]\n", issue.Key, issue.Fields.Summary, issue.Fields.Status.Name)
+	// Without a terminal, an explicit --title is the answer: create a new ticket
+	if !stdinIsTerminal() {
+		if createTitle != "" {
+			return true, nil
+		}
+		return false, errors.NewInteractiveInputError("what to do about "+issue.Key, "Pass --title to create a new ticket anyway, or run gci create in a terminal")
+	}
+
+	openOpt := fmt.Sprintf("Open %s in browser", issue.Key)
+	transitionOpt := fmt.Sprintf("Transition %s", issue.Key)
+	createOpt := "Create a new ticket anyway"
+	var action string
+	if err := survey.AskOne(&survey.Select{
+		Message: "What would you like to do?",
+		Options: []string{openOpt, transitionOpt, createOpt, "Cancel"},
+		Default: openOpt,
+	}, &action); err != nil {
+		return false, err
+	}
+
+	switch action {
+	case openOpt:
+		if err := openIssueInBrowser(config, issue); err != nil {
+			fmt.Printf("Open %s\n", issueURL(config, issue.Key))
+		}
+	case transitionOpt:
+		transitions, err := jira.FetchTransitions(config.JiraURL, config.Email, config.APIToken, issue.Key)
+		if err != nil {
+			return false, fmt.Errorf("failed to fetch transitions for %s: %w", issue.Key, err)
+		}
+		if len(transitions) == 0 {
+			fmt.Printf("No transitions are available for %s.\n", issue.Key)
+			return false, nil
+		}
+		options := make([]string, len(transitions))
+		for i, t := range transitions {
+			options[i] = fmt.Sprintf("%s → %s", t.Name, t.To.Name)
+		}
+		var choice int
+		if err := survey.AskOne(&survey.Select{Message: "Transition:", Options: options}, &choice); err != nil {
+			return false, err
+		}
+		_, reason, err := transitionIssue(config, issue, transitions[choice].ID)
+		if err != nil {
+			return false, fmt.Errorf("failed to transition %s: %w", issue.Key, err)
+		}
+		fmt.Printf("\033[92m%s\033[0m\n", reason)
+	case createOpt:
+		return true, nil
+	}
+	return false, nil
+}
+
 // runCreate is the orchestrator for the `gci create` command
-func runCreate(cmd *cobra.Command, args []string) {
+func runCreate(cmd *cobra.Command, args []string) error {
 	config, err := loadConfig()
 	if err != nil {
-		log.Fatalf("Failed to load config: %v", err)
+		return err
+	}
+
+	if createIssueType == "" {
+		createIssueType = config.DefaultIssueType
 	}
 
 	currentBranch := getCurrentBranch()
 	onProtected := isProtectedBranch(currentBranch)
 
+	// A keyed branch usually means the work is already tracked; offer that ticket first
+	var related string
+	if key := issueKeyFromBranch(config, currentBranch); key != "" {
+		if project := strings.SplitN(key, "-", 2)[0]; containsString(usercfg.GetRuntimeProjects(), project) {
+			if err := config.useProjectToken(project); err != nil {
+				return err
+			}
+		}
+		existing, err := fetchIssueDetails(config, key)
+		if err != nil {
+			logger.JIRA("could not fetch %s from branch %s: %v", key, currentBranch, err)
+		} else {
+			createAnyway, err := offerExistingIssue(config, existing)
+			if err != nil {
+				if isPromptCancel(err) {
+					fmt.Println("\n\033[93mOperation cancelled by user.\033[0m")
+					return nil
+				}
+				return err
+			}
+			if !createAnyway {
+				return nil
+			}
+			related = fmt.Sprintf("%s (%s)", existing.Key, existing.Fields.Summary)
+		}
+	}
+
 	// Capture changes
-	fmt.Println("Capturing changes...")
-	diff, err := captureGitDiff()
+	notef("Capturing changes...\n")
+	diffLimit := createDiffLimit
+	if diffLimit <= 0 {
+		diffLimit = config.DiffCharLimit
+	}
+	if diffLimit <= 0 {
+		diffLimit = defaultDiffCharLimit
+	}
+	diff, err := captureGitDiff(diffLimit)
 	if err != nil {
 		fmt.Printf("\033[93m%v\033[0m\n", err)
-		return
+		return nil
 	}
 
 	// Show diff stats
@@ -1054,11 +2620,13 @@ func runCreate(cmd *cobra.Command, args []string) {
 		suggestion ticketSuggestion
 		err        error
 	}
+	// --title replaces the suggestion entirely
+	useClaude := config.EnableClaude && createTitle == ""
 	var suggCh chan suggestionResult
-	if config.EnableClaude {
+	if useClaude {
 		suggCh = make(chan suggestionResult, 1)
 		go func() {
-			s, err := generateTicketSuggestion(diff, createModel)
+			s, err := generateTicketSuggestion(diff, createModel, related)
 			suggCh <- suggestionResult{s, err}
 		}()
 	}
@@ -1066,30 +2634,67 @@ func runCreate(cmd *cobra.Command, args []string) {
 	// Resolve project (user prompt runs concurrently with Claude when enabled)
 	project, err := resolveTargetProject(config)
 	if err != nil {
+		var userErr *errors.UserError
+		if stderrors.As(err, &userErr) {
+			return err
+		}
 		fmt.Println("\n\033[93mOperation cancelled by user.\033[0m")
-		return
+		return nil
+	}
+
+	// Projects may use their own credential (project_token_paths)
+	if err := config.useProjectToken(project); err != nil {
+		return err
+	}
+
+	// Pre-flight: catch an issue type the project doesn't allow before JIRA rejects it with a 400
+	if !createNoValidate {
+		issueType, err := validateIssueType(config, project, createIssueType)
+		if err != nil {
+			return err
+		}
+		createIssueType = issueType
 	}
 
 	// Get ticket suggestion
 	var suggResult suggestionResult
-	if config.EnableClaude {
-		fmt.Println("\nGenerating ticket suggestion...")
+	if useClaude {
+		notef("\nGenerating ticket suggestion...\n")
 		suggResult = <-suggCh
 	} else {
 		s, err := manualTicketEntry()
 		suggResult = suggestionResult{s, err}
 	}
 	if suggResult.err != nil {
+		var userErr *errors.UserError
+		if stderrors.As(suggResult.err, &userErr) {
+			return suggResult.err
+		}
 		fmt.Println("\n\033[93mOperation cancelled by user.\033[0m")
-		return
+		return nil
 	}
 	suggestion := suggResult.suggestion
 
 	// Confirm with user
 	title, description, err := confirmTicketDetails(suggestion)
 	if err != nil {
+		var userErr *errors.UserError
+		if stderrors.As(err, &userErr) {
+			return err
+		}
 		fmt.Println("\n\033[93mOperation cancelled by user.\033[0m")
-		return
+		return nil
+	}
+
+	// Components (flag > config > prompt when the project requires them)
+	components, err := resolveComponents(config, project, createIssueType)
+	if err != nil {
+		var userErr *errors.UserError
+		if stderrors.As(err, &userErr) {
+			return err
+		}
+		fmt.Println("\n\033[93mOperation cancelled by user.\033[0m")
+		return nil
 	}
 
 	// Dry-run: print summary and exit
@@ -1097,37 +2702,42 @@ func runCreate(cmd *cobra.Command, args []string) {
 		fmt.Println("\n\033[96m[dry-run] Would create:\033[0m")
 		fmt.Printf("  Project:     %s\n", project)
 		fmt.Printf("  Type:        %s\n", createIssueType)
+		if len(components) > 0 {
+			fmt.Printf("  Components:  %s\n", strings.Join(components, ", "))
+		}
 		fmt.Printf("  Title:       %s\n", title)
 		fmt.Printf("  Description: %s\n", description)
-		branchPreview := makeBranchName(project+"-???", title)
+		branchPreview := makeBranchName(config, project+"-???", title)
 		fmt.Printf("  Branch:      %s\n", branchPreview)
-		return
+		return nil
 	}
 
 	// Create the ticket
-	fmt.Print("Creating ticket... ")
+	notef("Creating ticket... ")
 	accountId, err := getMyAccountId(config)
 	if err != nil {
-		log.Fatalf("Failed to get JIRA account: %v", err)
+		return fmt.Errorf("failed to get JIRA account: %w", err)
 	}
 
-	issueKey, err := createJiraIssue(config, project, title, description, createIssueType, accountId)
+	issueKey, err := createJiraIssue(config, project, title, description, createIssueType, accountId, components)
 	if err != nil {
-		log.Fatalf("Failed to create JIRA issue: %v", err)
+		return fmt.Errorf("failed to create JIRA issue: %w", err)
 	}
 	fmt.Printf("\033[92m%s\033[0m\n", issueKey)
 
 	// Branch rename
-	newBranch := makeBranchName(issueKey, title)
+	newBranch := makeBranchName(config, issueKey, title)
 	if !createNoRename {
-		if onProtected {
-			fmt.Printf("On protected branch %q — creating new branch %q\n", currentBranch, newBranch)
-			if err := createOrCheckoutBranch(newBranch); err != nil {
+		if branchAlreadyMatches(config, currentBranch, issueKey, title) {
+			notef("Branch already matches %s: %s\n", issueKey, currentBranch)
+		} else if onProtected {
+			notef("On protected branch %q — creating new branch %q\n", currentBranch, newBranch)
+			if _, err := checkoutIssueBranch(newBranch, issueKey, config.OnDirtyTree); err != nil {
 				fmt.Printf("\033[91mFailed to create branch: %v\033[0m\n", err)
 				fmt.Println("You can rename manually with: git checkout -b", newBranch)
 			}
 		} else {
-			fmt.Printf("Renaming branch... %s -> %s\n", currentBranch, newBranch)
+			notef("Renaming branch... %s -> %s\n", currentBranch, newBranch)
 			if err := renameBranch(newBranch); err != nil {
 				fmt.Printf("\033[91m%v\033[0m\n", err)
 				fmt.Println("You can rename manually with: git branch -m", newBranch)
@@ -1170,7 +2780,7 @@ func runCreate(cmd *cobra.Command, args []string) {
 			Default: "Yes, all files",
 		}, &action); err != nil {
 			fmt.Printf("\nView: %s/browse/%s\n", config.JiraURL, issueKey)
-			return
+			return nil
 		}
 
 		var filesToStage []string
@@ -1183,11 +2793,11 @@ func runCreate(cmd *cobra.Command, args []string) {
 				Options: changedFiles,
 			}, &filesToStage); err != nil || len(filesToStage) == 0 {
 				fmt.Printf("\nView: %s/browse/%s\n", config.JiraURL, issueKey)
-				return
+				return nil
 			}
 		default:
 			fmt.Printf("\nView: %s/browse/%s\n", config.JiraURL, issueKey)
-			return
+			return nil
 		}
 
 		// Stage selected files (from repo root so porcelain paths resolve)
@@ -1197,7 +2807,7 @@ func runCreate(cmd *cobra.Command, args []string) {
 		if out, err := addCmd.CombinedOutput(); err != nil {
 			fmt.Printf("\033[91mFailed to stage files: %s\033[0m\n", strings.TrimSpace(string(out)))
 			fmt.Printf("\nView: %s/browse/%s\n", config.JiraURL, issueKey)
-			return
+			return nil
 		}
 
 		// Commit
@@ -1206,9 +2816,9 @@ func runCreate(cmd *cobra.Command, args []string) {
 		if out, err := commitCmd.CombinedOutput(); err != nil {
 			fmt.Printf("\033[91mCommit failed: %s\033[0m\n", strings.TrimSpace(string(out)))
 			fmt.Printf("\nView: %s/browse/%s\n", config.JiraURL, issueKey)
-			return
+			return nil
 		}
-		fmt.Printf("\033[92mCommitted.\033[0m\n")
+		notef("\033[92mCommitted.\033[0m\n")
 
 		// Push
 		currentBranchNow := getCurrentBranch()
@@ -1217,12 +2827,13 @@ func runCreate(cmd *cobra.Command, args []string) {
 		if out, err := pushCmd.CombinedOutput(); err != nil {
 			fmt.Printf("\033[91mPush failed: %s\033[0m\n", strings.TrimSpace(string(out)))
 		} else {
-			fmt.Printf("\033[92mPushed to origin/%s.\033[0m\n", currentBranchNow)
+			notef("\033[92mPushed to origin/%s.\033[0m\n", currentBranchNow)
 			_ = out
 		}
 	}
 
 	fmt.Printf("\nView: %s/browse/%s\n", config.JiraURL, issueKey)
+	return nil
 }
 
 // ---- TUI: Personal Kanban ----
@@ -1262,6 +2873,61 @@ type kanbanColumn struct {
 	issues         []JiraIssue
 }
 
+// statusCategoryName returns the instance's display name for a stable statusCategory
+// key, preferring config overrides, then names learned from JIRA, then English defaults.
+func (c *Config) statusCategoryName(key string) string {
+	if name := c.StatusCategoryNames[key]; name != "" {
+		return name
+	}
+	return jira.DefaultStatusCategoryNames[key]
+}
+
+// otherStatusCategory marks the catch-all board column; it matches every status
+// category not shown in the regular columns
+const otherStatusCategory = "__other__"
+
+// statusCategoryPredicate returns the JQL predicate for a column's status category
+func statusCategoryPredicate(config *Config, statusCategory string) string {
+	if statusCategory != otherStatusCategory {
+		return fmt.Sprintf("statusCategory = \"%s\"", statusCategory)
+	}
+	var names []string
+	for _, key := range []string{jira.StatusCategoryNew, jira.StatusCategoryIndeterminate, jira.StatusCategoryDone} {
+		names = append(names, fmt.Sprintf("\"%s\"", config.statusCategoryName(key)))
+	}
+	return fmt.Sprintf("statusCategory not in (%s)", strings.Join(names, ", "))
+}
+
+// resolveStatusCategoryNames fills in any statusCategory names not set in config by
+// asking JIRA, so columns match on localized instances. Failures fall back to English.
+func resolveStatusCategoryNames(config *Config) {
+	names := make(map[string]string, len(jira.DefaultStatusCategoryNames))
+	for key, name := range config.StatusCategoryNames {
+		names[key] = name
+	}
+
+	missing := false
+	for key := range jira.DefaultStatusCategoryNames {
+		if names[key] == "" {
+			missing = true
+			break
+		}
+	}
+	if missing {
+		fetched, err := jira.FetchStatusCategories(config.JiraURL, config.Email, config.APIToken)
+		if err != nil {
+			logger.JIRA("status category lookup failed, using English names: %v", err)
+		}
+		for key, name := range fetched {
+			if names[key] == "" {
+				names[key] = name
+			}
+		}
+	}
+
+	config.StatusCategoryNames = names
+}
+
 // buildProjectFilter creates the JQL project predicate
 func buildProjectFilter(projects []string) string {
 	if len(projects) == 1 {
@@ -1285,94 +2951,89 @@ func buildScopePredicate(scope scopeFilter) string {
 	}
 }
 
-// getFieldsList returns the appropriate fields list based on UI preferences
-func getFieldsList() string {
-	fields := "summary,project,issuetype,parent,status"
-	uiPrefs := usercfg.GetUIPrefs()
-	if uiPrefs.ShowExtraFields {
-		// Add assignee and priority for extra fields display
-		fields += ",assignee,priority"
+// getFieldsList returns the appropriate fields list based on UI preferences, plus the
+// custom fields config reads
+func getFieldsList(config *Config) string {
+	// Priority is always fetched so the board's priority: filter works in every layout
+	fields := "summary,project,issuetype,parent,status,priority"
+	switch rowLayoutFromPrefs(usercfg.GetUIPrefs()) {
+	case rowNormal:
+		// Add assignee for extra fields display
+		fields += ",assignee"
+	case rowDetailed:
+		fields += ",assignee,updated"
+	}
+	return fields + customFieldsList(config)
+}
+
+// customFieldsList returns ",id" for each custom field config reads, for a fields list
+func customFieldsList(config *Config) string {
+	var fields string
+	if config.EpicLinkField != "" {
+		fields += "," + config.EpicLinkField
+	}
+	if config.FlaggedField != "" {
+		fields += "," + config.FlaggedField
 	}
 	return fields
 }
 
-// fetchColumnIssues fetches up to maxResults issues for a given statusCategory + scope
-func fetchColumnIssues(config *Config, statusCategory string, scope scopeFilter, maxResults int) ([]JiraIssue, error) {
-	projectFilter := buildProjectFilter(config.Projects)
-	scopePredicate := buildScopePredicate(scope)
-
-	var predicates []string
-	predicates = append(predicates, projectFilter)
-	predicates = append(predicates, fmt.Sprintf("statusCategory = \"%s\"", statusCategory))
-	if scopePredicate != "" {
+// columnJQL builds the query for one board column: a statusCategory + scope, or + the
+// active JQL preset in place of the scope
+func columnJQL(config *Config, statusCategory string, scope scopeFilter) string {
+	predicates := []string{buildProjectFilter(config.Projects), statusCategoryPredicate(config, statusCategory)}
+	orderBy := "updated DESC"
+	if config.PresetJQL != "" {
+		where, presetOrder := splitOrderBy(config.PresetJQL)
+		predicates = append(predicates, "("+where+")")
+		if presetOrder != "" {
+			orderBy = presetOrder
+		}
+	} else if scopePredicate := buildScopePredicate(scope); scopePredicate != "" {
 		predicates = append(predicates, scopePredicate)
 	}
-	jql := strings.Join(predicates, " AND ") + " ORDER BY updated DESC"
+	return strings.Join(predicates, " AND ") + " ORDER BY " + orderBy
+}
 
-	ctx, cancel := context.WithTimeout(context.Background(), httputil.DefaultTimeout)
-	defer cancel()
-	
-	client := httputil.NewDefaultClient()
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/rest/api/3/search/jql", config.JiraURL), nil)
-	if err != nil {
-		return nil, err
+// splitOrderBy splits a JQL query into its condition and its ORDER BY clause (without
+// the keywords), either of which may be empty
+func splitOrderBy(jql string) (string, string) {
+	idx := strings.LastIndex(strings.ToLower(jql), "order by")
+	if idx < 0 {
+		return strings.TrimSpace(jql), ""
 	}
-	req.SetBasicAuth(config.Email, config.APIToken)
-	req.Header.Set("Accept", "application/json")
-	q := req.URL.Query()
-	q.Add("jql", jql)
-	q.Add("maxResults", fmt.Sprintf("%d", maxResults))
-	q.Add("fields", getFieldsList())
-	req.URL.RawQuery = q.Encode()
+	return strings.TrimSpace(jql[:idx]), strings.TrimSpace(jql[idx+len("order by"):])
+}
 
-	logger.HTTP("GET", req.URL.String())
-	
-	var jiraResp JiraResponse
-	if err := client.DoJSONRequest(ctx, req, &jiraResp); err != nil {
+// fetchColumnIssues fetches up to maxResults issues for a given statusCategory + scope
+func fetchColumnIssues(config *Config, statusCategory string, scope scopeFilter, maxResults int) ([]JiraIssue, error) {
+	jql := columnJQL(config, statusCategory, scope)
+
+	ctx, cancel := context.WithTimeout(context.Background(), httputil.Timeout(httputil.DefaultTimeout))
+	defer cancel()
+
+	issues, err := searchJQL(ctx, config, jql, getFieldsList(config), maxResults)
+	if err != nil {
 		logger.JIRA("request failed: %v", err)
 		return nil, errors.WrapWithContext(err, "jira_connection")
 	}
 	
-	logger.JIRA("Fetched %d issues for statusCategory=%q scope=%q", len(jiraResp.Issues), statusCategory, scopeToString(scope))
-	return jiraResp.Issues, nil
+	logger.JIRA("Fetched %d issues for statusCategory=%q scope=%q", len(issues), statusCategory, scopeToString(scope))
+	return issues, nil
 }
 
 // fetchColumnIssuesWithContext fetches column issues with a provided context for cancellation
 func fetchColumnIssuesWithContext(ctx context.Context, config *Config, statusCategory string, scope scopeFilter, maxResults int) ([]JiraIssue, error) {
-	projectFilter := buildProjectFilter(config.Projects)
-	scopePredicate := buildScopePredicate(scope)
+	jql := columnJQL(config, statusCategory, scope)
 
-	var predicates []string
-	predicates = append(predicates, projectFilter)
-	predicates = append(predicates, fmt.Sprintf("statusCategory = \"%s\"", statusCategory))
-	if scopePredicate != "" {
-		predicates = append(predicates, scopePredicate)
-	}
-	jql := strings.Join(predicates, " AND ") + " ORDER BY updated DESC"
-	
-	client := httputil.NewDefaultClient()
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/rest/api/3/search/jql", config.JiraURL), nil)
+	issues, err := searchJQL(ctx, config, jql, getFieldsList(config), maxResults)
 	if err != nil {
-		return nil, err
-	}
-	req.SetBasicAuth(config.Email, config.APIToken)
-	req.Header.Set("Accept", "application/json")
-	q := req.URL.Query()
-	q.Add("jql", jql)
-	q.Add("maxResults", fmt.Sprintf("%d", maxResults))
-	q.Add("fields", getFieldsList())
-	req.URL.RawQuery = q.Encode()
-
-	logger.HTTP("GET", req.URL.String())
-	
-	var jiraResp JiraResponse
-	if err := client.DoJSONRequest(ctx, req, &jiraResp); err != nil {
 		logger.JIRA("request failed: %v", err)
 		return nil, errors.WrapWithContext(err, "jira_connection")
 	}
 	
-	logger.JIRA("Fetched %d issues for statusCategory=%q scope=%q", len(jiraResp.Issues), statusCategory, scopeToString(scope))
-	return jiraResp.Issues, nil
+	logger.JIRA("Fetched %d issues for statusCategory=%q scope=%q", len(issues), statusCategory, scopeToString(scope))
+	return issues, nil
 }
 
 // fetchIssuesWithJQL fetches issues using a custom JQL query
@@ -1383,49 +3044,175 @@ func fetchIssuesWithJQL(config *Config, jql string, maxResults int) ([]JiraIssue
 		jql = projectFilter + " AND (" + jql + ")"
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), httputil.DefaultTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), httputil.Timeout(httputil.DefaultTimeout))
 	defer cancel()
-	
+
+	issues, err := searchJQL(ctx, config, jql, getFieldsList(config), maxResults)
+	if err != nil {
+		logger.JIRA("JQL request failed: %v", err)
+		return nil, errors.WrapWithContext(err, "jira_connection")
+	}
+	return issues, nil
+}
+
+// issueKeyBatchSize caps the keys in one `key in (...)` query to stay well under JQL
+// length limits
+const issueKeyBatchSize = 50
+
+// keyInJQL builds a `key in (...)` query for keys
+func keyInJQL(keys []string) string {
+	return fmt.Sprintf("key in (%s)", strings.Join(keys, ", "))
+}
+
+// keyBatches splits keys into runs of at most size, dropping duplicates
+func keyBatches(keys []string, size int) [][]string {
+	seen := make(map[string]bool, len(keys))
+	var batches [][]string
+	var batch []string
+	for _, key := range keys {
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		batch = append(batch, key)
+		if len(batch) == size {
+			batches = append(batches, batch)
+			batch = nil
+		}
+	}
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+	return batches
+}
+
+// fetchIssuesByKeys looks up issues by key in batches of issueKeyBatchSize. JIRA
+// rejects a whole batch if one key doesn't exist (e.g. a deleted issue), so a failed
+// batch is retried key by key and unknown keys are left out.
+func fetchIssuesByKeys(config *Config, keys []string, fields string) (map[string]JiraIssue, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), httputil.Timeout(httputil.DefaultTimeout))
+	defer cancel()
+
+	found := make(map[string]JiraIssue, len(keys))
+	for _, batch := range keyBatches(keys, issueKeyBatchSize) {
+		issues, err := searchJQL(ctx, config, keyInJQL(batch), fields, len(batch))
+		if err != nil {
+			if len(batch) == 1 {
+				logger.JIRA("lookup of %s failed: %v", batch[0], err)
+				continue
+			}
+			if ctx.Err() != nil {
+				return nil, err
+			}
+			for _, key := range batch {
+				if issues, err := searchJQL(ctx, config, keyInJQL([]string{key}), fields, 1); err == nil && len(issues) == 1 {
+					found[key] = issues[0]
+				} else if ctx.Err() != nil {
+					return nil, ctx.Err()
+				}
+			}
+			continue
+		}
+		for _, issue := range issues {
+			found[issue.Key] = issue
+		}
+	}
+	return found, nil
+}
+
+// rawIssueLimit caps the JSON shown by the board's raw issue view; issues with long
+// histories or many custom fields can run to megabytes
+const rawIssueLimit = 64 * 1024
+
+// fetchIssueJSON fetches an issue with all fields and returns it pretty-printed, cut
+// to rawIssueLimit bytes at a line break
+func fetchIssueJSON(config *Config, key string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), httputil.Timeout(httputil.DefaultTimeout))
+	defer cancel()
+
 	client := httputil.NewDefaultClient()
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/rest/api/3/search/jql", config.JiraURL), nil)
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/rest/api/3/issue/%s", config.JiraURL, key), nil)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 	req.SetBasicAuth(config.Email, config.APIToken)
 	req.Header.Set("Accept", "application/json")
 	q := req.URL.Query()
-	q.Add("jql", jql)
-	q.Add("maxResults", fmt.Sprintf("%d", maxResults))
-	q.Add("fields", getFieldsList())
+	q.Add("fields", "*all")
 	req.URL.RawQuery = q.Encode()
 
 	logger.HTTP("GET", req.URL.String())
-	
-	var jiraResp JiraResponse
-	if err := client.DoJSONRequest(ctx, req, &jiraResp); err != nil {
-		logger.JIRA("JQL request failed: %v", err)
-		return nil, errors.WrapWithContext(err, "jira_connection")
+
+	var raw json.RawMessage
+	if err := client.DoJSONRequest(ctx, req, &raw); err != nil {
+		return "", errors.WrapWithContext(err, "jira_connection")
 	}
-	
-	return jiraResp.Issues, nil
+	var out bytes.Buffer
+	if err := json.Indent(&out, raw, "", "  "); err != nil {
+		return "", err
+	}
+	if out.Len() > rawIssueLimit {
+		return truncateAtLine(out.String(), rawIssueLimit) + fmt.Sprintf("\n… truncated (%d of %d bytes shown)", rawIssueLimit, out.Len()), nil
+	}
+	return out.String(), nil
+}
+
+// fetchIssueDetails fetches a single issue including fields not requested by list
+// queries (e.g. description), for on-demand detail views
+func fetchIssueDetails(config *Config, key string) (JiraIssue, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), httputil.Timeout(httputil.DefaultTimeout))
+	defer cancel()
+
+	client := httputil.NewDefaultClient()
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/rest/api/3/issue/%s", config.JiraURL, key), nil)
+	if err != nil {
+		return JiraIssue{}, err
+	}
+	req.SetBasicAuth(config.Email, config.APIToken)
+	req.Header.Set("Accept", "application/json")
+	q := req.URL.Query()
+	q.Add("fields", "summary,project,issuetype,parent,status,assignee,priority,description"+customFieldsList(config))
+	req.URL.RawQuery = q.Encode()
+
+	logger.HTTP("GET", req.URL.String())
+
+	var raw json.RawMessage
+	if err := client.DoJSONRequest(ctx, req, &raw); err != nil {
+		logger.JIRA("issue details request failed: %v", err)
+		return JiraIssue{}, errors.WrapWithContext(err, "jira_connection")
+	}
+	return decodeIssue(config, raw)
 }
 
 // runBoard launches the TUI. We implement a very small in-terminal navigable board with columns.
-func runBoard(cmd *cobra.Command, args []string) {
+func runBoard(cmd *cobra.Command, args []string) error {
 	config, err := loadConfig()
 	if err != nil {
-		log.Fatalf("Failed to load config: %v", err)
+		return err
+	}
+	if boardTemplate != "" {
+		if err := renderBoardTemplate(config, boardTemplate, os.Stdout); err != nil {
+			return fmt.Errorf("board template failed: %w", err)
+		}
+		return nil
 	}
 	if err := StartBoard(config); err != nil {
-		log.Fatalf("Board failed: %v", err)
+		return fmt.Errorf("board failed: %w", err)
 	}
+	return nil
 }
 
 func runSetup(cmd *cobra.Command, args []string) {
 	fmt.Println("GCI Setup Wizard")
 	fmt.Println("=================")
 
-	currentConfig := usercfg.GetRuntimeConfig()
+	// Seed from the user's own file: the runtime config would save a repo's .gci.toml and
+	// env overrides into it
+	currentConfig, err := usercfg.Load()
+	if err != nil && err != usercfg.ErrNotConfigured {
+		fmt.Printf("Failed to load config: %v\n", err)
+		os.Exit(1)
+	}
 	newConfig := currentConfig
 	isFirstRun := !usercfg.IsConfigured()
 
@@ -1468,26 +3255,15 @@ func runSetup(cmd *cobra.Command, args []string) {
 		}
 	}
 
-	if setupProjects {
-		var projectInput string
-		defaultVal := strings.Join(currentConfig.Projects, ", ")
-		if err := survey.AskOne(&survey.Input{
-			Message: "Project keys (comma-separated, e.g. PROJ,INFRA):",
-			Default: defaultVal,
-		}, &projectInput, survey.WithValidator(survey.Required)); err != nil {
+	// --projects-from-jira picks them once authentication is sorted out below
+	if setupProjects && !setupProjectsFromJira {
+		projects, err := promptProjectKeys(currentConfig.Projects)
+		if err != nil {
 			fmt.Println("Setup cancelled")
 			return
 		}
-		projects := strings.Split(projectInput, ",")
-		var cleaned []string
-		for _, p := range projects {
-			p = strings.TrimSpace(p)
-			if p != "" {
-				cleaned = append(cleaned, strings.ToUpper(p))
-			}
-		}
-		if len(cleaned) > 0 {
-			newConfig.Projects = cleaned
+		if len(projects) > 0 {
+			newConfig.Projects = projects
 		}
 	}
 
@@ -1589,16 +3365,49 @@ func runSetup(cmd *cobra.Command, args []string) {
 			}
 		}
 
-		var jiraItemName string
-		if err := survey.AskOne(&survey.Input{
-			Message: "1Password item name for JIRA API token:",
-			Default: existingJiraItem,
-		}, &jiraItemName, survey.WithValidator(survey.Required)); err != nil {
-			fmt.Println("Setup cancelled")
-			return
+		_, opErr := exec.LookPath("op")
+		for {
+			var jiraItemName string
+			if err := survey.AskOne(&survey.Input{
+				Message: "1Password item name for JIRA API token:",
+				Default: existingJiraItem,
+			}, &jiraItemName, survey.WithValidator(survey.Required)); err != nil {
+				fmt.Println("Setup cancelled")
+				return
+			}
+			tokenPath := fmt.Sprintf("op://Private/%s/credential", jiraItemName)
+			newConfig.OPJiraTokenPath = tokenPath
+
+			// Without the CLI there's nothing to test yet
+			if opErr != nil {
+				break
+			}
+			fmt.Printf("  Testing op read %s...\n", tokenPath)
+			err := verifyOnePasswordToken(tokenPath)
+			if err == nil {
+				fmt.Println("  ✅ Read the API token from 1Password")
+				break
+			}
+			fmt.Printf("  ❌ %v\n", errors.NewOnePasswordError(err))
+
+			var next string
+			if err := survey.AskOne(&survey.Select{
+				Message: "The token could not be read. What now?",
+				Options: []string{"Re-enter the item name", "Keep this path anyway", "Skip 1Password"},
+			}, &next); err != nil {
+				fmt.Println("Setup cancelled")
+				return
+			}
+			if next == "Keep this path anyway" {
+				break
+			}
+			if next == "Skip 1Password" {
+				newConfig.OPJiraTokenPath = currentConfig.OPJiraTokenPath
+				fmt.Println("  Skipped 1Password setup. Set JIRA_API_TOKEN to authenticate.")
+				break
+			}
+			existingJiraItem = jiraItemName
 		}
-		newConfig.OPJiraTokenPath = fmt.Sprintf("op://Private/%s/credential", jiraItemName)
-
 	}
 
 	// Claude AI integration
@@ -1637,7 +3446,7 @@ func runSetup(cmd *cobra.Command, args []string) {
 	}
 
 	// Resolve auth inline for email detection and board discovery.
-	// We do this directly instead of loadConfig() to avoid its os.Exit guard
+	// We do this directly instead of loadConfig() to avoid its not-configured guard
 	// and to handle the email mismatch case before anything depends on it.
 	var authEmail, apiToken string
 	var authOK bool
@@ -1652,17 +3461,18 @@ func runSetup(cmd *cobra.Command, args []string) {
 	apiToken = os.Getenv("JIRA_API_TOKEN")
 	if apiToken == "" && newConfig.OPJiraTokenPath != "" {
 		fmt.Println("\nVerifying JIRA authentication via 1Password...")
-		if out, err := exec.Command("op", "read", newConfig.OPJiraTokenPath).Output(); err == nil {
-			apiToken = strings.TrimSpace(string(out))
+		token, err := readOnePasswordSecret(newConfig.OPJiraTokenPath)
+		if err != nil {
+			fmt.Printf("Warning: %v\n", errors.NewOnePasswordError(err))
 		}
+		apiToken = token
 	}
 
 	// Resolve JIRA email: prefer 1Password username, fall back to git email + /myself
 	if newConfig.OPJiraTokenPath != "" {
 		// Derive username path from credential path: op://Private/<item>/credential → op://Private/<item>/username
 		usernamePath := strings.TrimSuffix(newConfig.OPJiraTokenPath, "/credential") + "/username"
-		if out, err := exec.Command("op", "read", usernamePath).Output(); err == nil {
-			opEmail := strings.TrimSpace(string(out))
+		if opEmail, err := readOnePasswordSecret(usernamePath); err == nil {
 			if opEmail != "" {
 				authEmail = opEmail
 
@@ -1726,6 +3536,35 @@ func runSetup(cmd *cobra.Command, args []string) {
 		}
 	}
 
+	if setupProjects && setupProjectsFromJira {
+		var projects []string
+		var err error
+		if authOK {
+			fmt.Println("\nListing your JIRA projects...")
+			var available []jira.Project
+			available, err = jira.SearchProjects(newConfig.JiraURL, authEmail, apiToken)
+			if err != nil {
+				fmt.Printf("Warning: Could not list projects: %v\n", err)
+			} else if len(available) > 0 {
+				if projects, err = pickProjects(available, currentConfig.Projects); err != nil {
+					fmt.Println("Setup cancelled")
+					return
+				}
+			}
+		} else {
+			fmt.Println("\nJIRA authentication isn't working, so projects can't be listed.")
+		}
+		if len(projects) == 0 {
+			if projects, err = promptProjectKeys(currentConfig.Projects); err != nil {
+				fmt.Println("Setup cancelled")
+				return
+			}
+		}
+		if len(projects) > 0 {
+			newConfig.Projects = projects
+		}
+	}
+
 	// Save again if email detection added a domain mapping
 	if err := usercfg.Save(newConfig); err != nil {
 		log.Fatalf("Failed to save configuration: %v", err)
@@ -1733,12 +3572,18 @@ func runSetup(cmd *cobra.Command, args []string) {
 
 	// Board discovery — automatic when auth is available
 	if authOK {
+		// Catch project key typos and permission problems before they show up as empty boards
+		fmt.Println("\nVerifying projects on JIRA...")
+		if reportProjectAccess(newConfig.JiraURL, authEmail, apiToken, newConfig.Projects) > 0 {
+			fmt.Println("   Re-run gci setup to fix the project keys, or check your JIRA permissions.")
+		}
+
 		fmt.Println("\nDiscovering project boards from JIRA...")
 		boards, err := jira.DiscoverBoards(newConfig.JiraURL, authEmail, apiToken, newConfig.Projects...)
 		if err != nil {
 			fmt.Printf("Warning: Board discovery failed: %v\n", err)
 		} else {
-			rankedBoards := jira.RankBoards(boards, newConfig.Projects)
+			rankedBoards := jira.RankBoardsPreferring(boards, newConfig.Projects, newConfig.BoardRankPrefer)
 
 			if len(rankedBoards) > 0 {
 				var boardOptions []string
@@ -1786,12 +3631,127 @@ func runSetup(cmd *cobra.Command, args []string) {
 	}
 }
 
+// promptProjectKeys asks for comma-separated project keys and returns them uppercased
+func promptProjectKeys(current []string) ([]string, error) {
+	var projectInput string
+	if err := survey.AskOne(&survey.Input{
+		Message: "Project keys (comma-separated, e.g. PROJ,INFRA):",
+		Default: strings.Join(current, ", "),
+	}, &projectInput, survey.WithValidator(survey.Required)); err != nil {
+		return nil, err
+	}
+	var cleaned []string
+	for _, p := range strings.Split(projectInput, ",") {
+		p = strings.TrimSpace(p)
+		if p != "" {
+			cleaned = append(cleaned, strings.ToUpper(p))
+		}
+	}
+	return cleaned, nil
+}
+
+// pickProjects offers the projects JIRA listed in a multi-select, with the current ones
+// checked, and returns the chosen keys
+func pickProjects(available []jira.Project, current []string) ([]string, error) {
+	configured := make(map[string]bool, len(current))
+	for _, key := range current {
+		configured[key] = true
+	}
+	var options, defaults []string
+	keyByOption := make(map[string]string, len(available))
+	for _, project := range available {
+		option := fmt.Sprintf("%s (%s)", project.Key, project.Name)
+		options = append(options, option)
+		keyByOption[option] = project.Key
+		if configured[project.Key] {
+			defaults = append(defaults, option)
+		}
+	}
+
+	var selected []string
+	if err := survey.AskOne(&survey.MultiSelect{
+		Message:  "Select your projects:",
+		Options:  options,
+		Default:  defaults,
+		PageSize: 15,
+	}, &selected); err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(selected))
+	for _, option := range selected {
+		keys = append(keys, keyByOption[option])
+	}
+	return keys, nil
+}
+
 func runConfigMigrate(cmd *cobra.Command, args []string) {
-	err := usercfg.MigrateAndSave()
+	backupPath, err := usercfg.MigrateAndSave()
 	if err != nil {
 		fmt.Printf("Migration failed: %v\n", err)
 		os.Exit(1)
 	}
+	fmt.Printf("Backed up config to %s\n", backupPath)
+}
+
+func runConfigBackup(cmd *cobra.Command, args []string) {
+	path, err := usercfg.Backup()
+	if err != nil {
+		fmt.Printf("Backup failed: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Backed up config to %s\n", path)
+}
+
+func runConfigRestore(cmd *cobra.Command, args []string) {
+	var path string
+	if len(args) == 1 {
+		path = args[0]
+		// A bare name refers to the backups directory
+		if _, err := os.Stat(path); os.IsNotExist(err) && filepath.Base(path) == path {
+			path = filepath.Join(usercfg.BackupDir(), path)
+		}
+	} else {
+		backups, err := usercfg.ListBackups()
+		if err != nil {
+			fmt.Printf("Could not list backups: %v\n", err)
+			os.Exit(1)
+		}
+		if len(backups) == 0 {
+			fmt.Printf("No backups in %s; make one with: gci config backup\n", usercfg.BackupDir())
+			os.Exit(1)
+		}
+		if !stdinIsTerminal() {
+			fmt.Println("Available backups (newest first):")
+			for _, b := range backups {
+				fmt.Printf("  %s\n", filepath.Base(b))
+			}
+			fmt.Println("Pass one to restore: gci config restore <file>")
+			os.Exit(1)
+		}
+		names := make([]string, len(backups))
+		for i, b := range backups {
+			names[i] = filepath.Base(b)
+		}
+		var choice string
+		if err := survey.AskOne(&survey.Select{
+			Message: "Restore which backup?",
+			Options: names,
+		}, &choice); err != nil {
+			fmt.Println("\n\033[93mOperation cancelled by user.\033[0m")
+			return
+		}
+		path = filepath.Join(usercfg.BackupDir(), choice)
+	}
+
+	previous, err := usercfg.Restore(path)
+	if err != nil {
+		fmt.Printf("Restore failed: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Restored config from %s\n", path)
+	if previous != "" {
+		fmt.Printf("The config it replaced was saved to %s\n", previous)
+	}
 }
 
 func runConfigPath(cmd *cobra.Command, args []string) {
@@ -1812,8 +3772,16 @@ func runConfigPrint(cmd *cobra.Command, args []string) {
 }
 
 func runConfigGet(cmd *cobra.Command, args []string) {
-	key := args[0]
 	config := usercfg.GetRuntimeConfig()
+	if configGetAll {
+		if err := usercfg.Export(os.Stdout, config, configGetFormat); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	key := args[0]
 
 	switch key {
 	case "projects":
@@ -1840,9 +3808,97 @@ func runConfigGet(cmd *cobra.Command, args []string) {
 		fmt.Println()
 	case "schema_version":
 		fmt.Println(config.SchemaVersion)
+	case "project_scopes":
+		var pairs []string
+		for project, scope := range config.ProjectScopes {
+			pairs = append(pairs, project+"="+scope)
+		}
+		sort.Strings(pairs)
+		fmt.Println(strings.Join(pairs, ","))
+	case "on_dirty_tree":
+		fmt.Println(config.OnDirtyTree)
+	case "default_project":
+		fmt.Println(config.DefaultProject)
+	case "worktree_base_dir":
+		fmt.Println(config.WorktreeBaseDir)
+	case "picker_statuses":
+		fmt.Println(strings.Join(config.PickerStatuses, ","))
+	case "on_start_transition":
+		fmt.Println(config.OnStartTransition)
+	case "separate_backlog":
+		fmt.Println(config.SeparateBacklog)
+	case "jira_email":
+		fmt.Println(config.JiraEmail)
+	case "op_jira_token_path":
+		fmt.Println(config.OPJiraTokenPath)
+	case "filter_key_weight":
+		fmt.Println(filterWeight(config.FilterKeyWeight))
+	case "filter_summary_weight":
+		fmt.Println(filterWeight(config.FilterSummaryWeight))
+	case "show_epics":
+		fmt.Println(config.ShowEpics)
+	case "epic_link_field":
+		fmt.Println(config.EpicLinkField)
+	case "flagged_field":
+		fmt.Println(config.FlaggedField)
+	case "refresh_on_focus":
+		fmt.Println(config.RefreshOnFocus)
+	case "unhide_on_refresh":
+		fmt.Println(config.UnhideOnRefresh)
+	case "keep_open":
+		fmt.Println(config.KeepOpen)
+	case "branch_strip_project":
+		fmt.Println(config.BranchStripProject)
+	case "branch_key_case":
+		if config.BranchKeyCase == "" {
+			fmt.Println("upper")
+		} else {
+			fmt.Println(config.BranchKeyCase)
+		}
+	case "jql_presets":
+		names := make([]string, 0, len(config.JQLPresets))
+		for name := range config.JQLPresets {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Printf("%s: %s\n", name, config.JQLPresets[name])
+		}
+	case "status_column_overrides":
+		var pairs []string
+		for status, column := range config.StatusColumnOverrides {
+			pairs = append(pairs, status+"="+column)
+		}
+		sort.Strings(pairs)
+		fmt.Println(strings.Join(pairs, ","))
+	case "assignee_display":
+		if config.AssigneeDisplay == "" {
+			fmt.Println("name")
+		} else {
+			fmt.Println(config.AssigneeDisplay)
+		}
+	case "issue_type_markers":
+		var pairs []string
+		for issueType, marker := range config.IssueTypeMarkers {
+			pairs = append(pairs, issueType+"="+marker)
+		}
+		sort.Strings(pairs)
+		fmt.Println(strings.Join(pairs, ","))
+	case "board_load_timeout_seconds":
+		if config.BoardLoadTimeoutSeconds > 0 {
+			fmt.Println(config.BoardLoadTimeoutSeconds)
+		} else {
+			fmt.Println(int(defaultBoardLoadTimeout / time.Second))
+		}
+	case "diff_char_limit":
+		if config.DiffCharLimit > 0 {
+			fmt.Println(config.DiffCharLimit)
+		} else {
+			fmt.Println(defaultDiffCharLimit)
+		}
 	default:
 		fmt.Printf("Unknown key: %s\n", key)
-		fmt.Println("Available keys: projects, default_scope, jira_url, boards, schema_version")
+		fmt.Println("Available keys: projects, default_scope, project_scopes, default_project, jira_url, boards, schema_version, on_dirty_tree, worktree_base_dir, picker_statuses, on_start_transition, separate_backlog, jira_email, op_jira_token_path, filter_key_weight, filter_summary_weight, show_epics, epic_link_field, flagged_field, refresh_on_focus, unhide_on_refresh, keep_open, issue_type_markers, assignee_display, status_column_overrides, board_load_timeout_seconds, diff_char_limit, branch_strip_project, branch_key_case, jql_presets")
 		os.Exit(1)
 	}
 }
@@ -1861,7 +3917,7 @@ func runConfigSet(cmd *cobra.Command, args []string) {
 	// Validate and set the value
 	switch key {
 	case "default_scope":
-		validScopes := []string{"assigned_or_reported", "assigned", "reported", "unassigned"}
+		validScopes := validScopeNames
 		valid := false
 		for _, scope := range validScopes {
 			if value == scope {
@@ -1876,6 +3932,24 @@ func runConfigSet(cmd *cobra.Command, args []string) {
 		}
 		config.DefaultScope = value
 
+	case "project_scopes":
+		scopes := make(map[string]string)
+		for _, pair := range strings.Split(value, ",") {
+			if pair = strings.TrimSpace(pair); pair == "" {
+				continue
+			}
+			project, scope, ok := strings.Cut(pair, "=")
+			project, scope = strings.TrimSpace(project), strings.TrimSpace(scope)
+			if !ok || !containsString(config.Projects, project) || !containsString(validScopeNames, scope) {
+				fmt.Printf("Invalid project_scopes entry: %s (want PROJECT=SCOPE)\n", pair)
+				fmt.Printf("Configured projects: %s\n", strings.Join(config.Projects, ", "))
+				fmt.Printf("Valid scopes: %s\n", strings.Join(validScopeNames, ", "))
+				os.Exit(1)
+			}
+			scopes[project] = scope
+		}
+		config.ProjectScopes = scopes
+
 	case "jira_url":
 		if !strings.HasPrefix(value, "http://") && !strings.HasPrefix(value, "https://") {
 			fmt.Printf("Invalid JIRA URL: %s (must start with http:// or https://)\n", value)
@@ -1883,13 +3957,195 @@ func runConfigSet(cmd *cobra.Command, args []string) {
 		}
 		config.JiraURL = value
 
+	case "on_dirty_tree":
+		if !containsString(validDirtyTreePolicies, value) {
+			fmt.Printf("Invalid on_dirty_tree: %s\n", value)
+			fmt.Printf("Valid values: %s\n", strings.Join(validDirtyTreePolicies, ", "))
+			os.Exit(1)
+		}
+		config.OnDirtyTree = value
+
+	case "default_project":
+		if value != "" && !containsString(config.Projects, value) {
+			fmt.Printf("Invalid default_project: %s\n", value)
+			fmt.Printf("Configured projects: %s\n", strings.Join(config.Projects, ", "))
+			os.Exit(1)
+		}
+		config.DefaultProject = value
+
+	case "worktree_base_dir":
+		config.WorktreeBaseDir = value
+
+	case "picker_statuses":
+		config.PickerStatuses = nil
+		for _, status := range strings.Split(value, ",") {
+			if status = strings.TrimSpace(status); status != "" {
+				config.PickerStatuses = append(config.PickerStatuses, status)
+			}
+		}
+
+	case "on_start_transition":
+		config.OnStartTransition = value
+
+	case "separate_backlog":
+		if !containsString(validSeparateBacklogModes, value) {
+			fmt.Printf("Invalid separate_backlog: %s\n", value)
+			fmt.Printf("Valid values: %s\n", strings.Join(validSeparateBacklogModes, ", "))
+			os.Exit(1)
+		}
+		config.SeparateBacklog = value
+
+	case "jira_email":
+		if value != "" && !isEmailAddress(value) {
+			fmt.Printf("Invalid jira_email: %s\n", value)
+			os.Exit(1)
+		}
+		config.JiraEmail = value
+
+	case "op_jira_token_path":
+		// An empty value clears it, leaving JIRA_API_TOKEN as the token source
+		if value != "" && !isOnePasswordPath(value) {
+			fmt.Printf("Invalid op_jira_token_path: %s (want op://vault/item/field)\n", value)
+			os.Exit(1)
+		}
+		config.OPJiraTokenPath = value
+
+	case "filter_key_weight", "filter_summary_weight":
+		weight, err := strconv.ParseFloat(value, 64)
+		if err != nil || weight <= 0 {
+			fmt.Printf("Invalid %s: %s (want a number greater than 0)\n", key, value)
+			os.Exit(1)
+		}
+		if key == "filter_key_weight" {
+			config.FilterKeyWeight = weight
+		} else {
+			config.FilterSummaryWeight = weight
+		}
+
+	case "show_epics":
+		show, err := strconv.ParseBool(value)
+		if err != nil {
+			fmt.Printf("Invalid show_epics: %s (want true or false)\n", value)
+			os.Exit(1)
+		}
+		config.ShowEpics = show
+
+	case "refresh_on_focus":
+		refresh, err := strconv.ParseBool(value)
+		if err != nil {
+			fmt.Printf("Invalid refresh_on_focus: %s (want true or false)\n", value)
+			os.Exit(1)
+		}
+		config.RefreshOnFocus = refresh
+
+	case "issue_type_markers":
+		markers := make(map[string]string)
+		for _, pair := range strings.Split(value, ",") {
+			if pair = strings.TrimSpace(pair); pair == "" {
+				continue
+			}
+			issueType, marker, ok := strings.Cut(pair, "=")
+			if issueType = strings.TrimSpace(issueType); !ok || issueType == "" {
+				fmt.Printf("Invalid issue_type_markers entry: %s (want TYPE=MARKER, e.g. Bug=[B]; an empty marker hides it)\n", pair)
+				os.Exit(1)
+			}
+			markers[issueType] = strings.TrimSpace(marker)
+		}
+		config.IssueTypeMarkers = markers
+
+	case "status_column_overrides":
+		overrides := make(map[string]string)
+		for _, pair := range strings.Split(value, ",") {
+			if pair = strings.TrimSpace(pair); pair == "" {
+				continue
+			}
+			status, column, ok := strings.Cut(pair, "=")
+			status, column = strings.TrimSpace(status), strings.TrimSpace(column)
+			title := boardColumnTitle(column)
+			if !ok || status == "" || title == "" {
+				fmt.Printf("Invalid status_column_overrides entry: %s (want STATUS=COLUMN)\n", pair)
+				fmt.Printf("Columns: %s\n", strings.Join(boardColumnTitles, ", "))
+				os.Exit(1)
+			}
+			overrides[status] = title
+		}
+		config.StatusColumnOverrides = overrides
+
+	case "assignee_display":
+		if value != "name" && value != "badges" {
+			fmt.Printf("Invalid assignee_display: %s (want name or badges)\n", value)
+			os.Exit(1)
+		}
+		config.AssigneeDisplay = value
+
+	case "unhide_on_refresh":
+		unhide, err := strconv.ParseBool(value)
+		if err != nil {
+			fmt.Printf("Invalid unhide_on_refresh: %s (want true or false)\n", value)
+			os.Exit(1)
+		}
+		config.UnhideOnRefresh = unhide
+
+	case "keep_open":
+		keepOpen, err := strconv.ParseBool(value)
+		if err != nil {
+			fmt.Printf("Invalid keep_open: %s (want true or false)\n", value)
+			os.Exit(1)
+		}
+		config.KeepOpen = keepOpen
+
+	case "branch_strip_project":
+		strip, err := strconv.ParseBool(value)
+		if err != nil {
+			fmt.Printf("Invalid branch_strip_project: %s (want true or false)\n", value)
+			os.Exit(1)
+		}
+		config.BranchStripProject = strip
+
+	case "branch_key_case":
+		if value != "upper" && value != "lower" {
+			fmt.Printf("Invalid branch_key_case: %s (want upper or lower)\n", value)
+			os.Exit(1)
+		}
+		config.BranchKeyCase = value
+
+	case "diff_char_limit":
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 1000 {
+			fmt.Printf("Invalid diff_char_limit: %s (want a number of characters, at least 1000)\n", value)
+			os.Exit(1)
+		}
+		config.DiffCharLimit = limit
+
+	case "board_load_timeout_seconds":
+		seconds, err := strconv.Atoi(value)
+		if err != nil || seconds < 1 {
+			fmt.Printf("Invalid board_load_timeout_seconds: %s (want a whole number of seconds, at least 1)\n", value)
+			os.Exit(1)
+		}
+		config.BoardLoadTimeoutSeconds = seconds
+
+	case "epic_link_field":
+		if value != "" && !strings.HasPrefix(value, "customfield_") {
+			fmt.Printf("Invalid epic_link_field: %s (want a custom field id like customfield_10014)\n", value)
+			os.Exit(1)
+		}
+		config.EpicLinkField = value
+
+	case "flagged_field":
+		if value != "" && !strings.HasPrefix(value, "customfield_") {
+			fmt.Printf("Invalid flagged_field: %s (want a custom field id like customfield_10021)\n", value)
+			os.Exit(1)
+		}
+		config.FlaggedField = value
+
 	case "projects", "boards", "schema_version":
 		fmt.Printf("Key '%s' cannot be set via 'config set'. Use 'gci setup' for projects and boards.\n", key)
 		os.Exit(1)
 
 	default:
 		fmt.Printf("Unknown key: %s\n", key)
-		fmt.Println("Settable keys: default_scope, jira_url")
+		fmt.Println("Settable keys: default_scope, project_scopes, default_project, jira_url, on_dirty_tree, worktree_base_dir, picker_statuses, on_start_transition, separate_backlog, jira_email, op_jira_token_path, filter_key_weight, filter_summary_weight, show_epics, epic_link_field, flagged_field, refresh_on_focus, unhide_on_refresh, keep_open, issue_type_markers, assignee_display, status_column_overrides, board_load_timeout_seconds, diff_char_limit, branch_strip_project, branch_key_case")
 		os.Exit(1)
 	}
 
@@ -1950,7 +4206,7 @@ func runConfigDoctor(cmd *cobra.Command, args []string) {
 	}
 
 	// Check default scope
-	validScopes := []string{"assigned_or_reported", "assigned", "reported", "unassigned"}
+	validScopes := validScopeNames
 	validScope := false
 	for _, scope := range validScopes {
 		if config.DefaultScope == scope {
@@ -1965,6 +4221,16 @@ func runConfigDoctor(cmd *cobra.Command, args []string) {
 	} else {
 		fmt.Printf("✅ Default scope is valid: %s\n", config.DefaultScope)
 	}
+	for project, scope := range config.ProjectScopes {
+		if !containsString(validScopeNames, scope) {
+			fmt.Printf("⚠️  Invalid project_scopes scope for %s: %s\n", project, scope)
+			fmt.Printf("   Valid scopes: %s\n", strings.Join(validScopeNames, ", "))
+			issues++
+		} else if !containsString(config.Projects, project) {
+			fmt.Printf("⚠️  project_scopes lists %s, which is not in projects\n", project)
+			issues++
+		}
+	}
 
 	// Check JIRA URL
 	if config.JiraURL == "" {
@@ -1979,6 +4245,53 @@ func runConfigDoctor(cmd *cobra.Command, args []string) {
 		fmt.Printf("✅ JIRA URL configured: %s\n", config.JiraURL)
 	}
 
+	// Check board rank preference
+	if config.BoardRankPrefer != "" && config.BoardRankPrefer != jira.BoardRankPreferOldest && config.BoardRankPrefer != jira.BoardRankPreferNewest {
+		fmt.Printf("⚠️  Invalid board_rank_prefer: %s\n", config.BoardRankPrefer)
+		fmt.Println("   Valid values: oldest, newest")
+		issues++
+	}
+
+	// Check dirty-tree policy
+	if !containsString(validDirtyTreePolicies, config.OnDirtyTree) {
+		fmt.Printf("⚠️  Invalid on_dirty_tree: %s\n", config.OnDirtyTree)
+		fmt.Printf("   Valid values: %s\n", strings.Join(validDirtyTreePolicies, ", "))
+		issues++
+	} else {
+		fmt.Printf("✅ on_dirty_tree is valid: %s\n", config.OnDirtyTree)
+	}
+
+	// Check backlog grouping
+	if config.SeparateBacklog != "" && !containsString(validSeparateBacklogModes, config.SeparateBacklog) {
+		fmt.Printf("⚠️  Invalid separate_backlog: %s\n", config.SeparateBacklog)
+		fmt.Printf("   Valid values: %s\n", strings.Join(validSeparateBacklogModes, ", "))
+		issues++
+	}
+
+	// Check default project
+	if config.DefaultProject != "" {
+		if !containsString(config.Projects, config.DefaultProject) {
+			fmt.Printf("⚠️  default_project %s is not a configured project\n", config.DefaultProject)
+			fmt.Printf("   Configured projects: %s\n", strings.Join(config.Projects, ", "))
+			issues++
+		} else {
+			fmt.Printf("✅ Default project is valid: %s\n", config.DefaultProject)
+		}
+	}
+
+	// Live checks against JIRA
+	if configDoctorOffline {
+		fmt.Println("ℹ️  Skipping live JIRA checks (--offline)")
+	} else if strings.HasPrefix(config.JiraURL, "http") && len(config.Projects) > 0 {
+		email, token := doctorCredentials(config)
+		if token == "" {
+			fmt.Println("ℹ️  Skipping live JIRA checks: no API token (set JIRA_API_TOKEN, op_jira_token_path, or project_token_paths)")
+		} else {
+			issues += reportProjectAccess(config.JiraURL, email, token, config.Projects)
+			issues += reportPickerStatuses(config.JiraURL, email, token, config.PickerStatuses)
+		}
+	}
+
 	fmt.Println()
 	if issues == 0 {
 		fmt.Println("🎉 No issues found! Configuration looks healthy.")
@@ -1988,6 +4301,66 @@ func runConfigDoctor(cmd *cobra.Command, args []string) {
 	}
 }
 
+// doctorCredentials resolves the email and API token the same way loadConfig does,
+// returning an empty token instead of failing so offline checks still run
+func doctorCredentials(config usercfg.Config) (string, string) {
+	tokenPath := tokenPathForProjects(config, config.Projects)
+	email, _ := resolveEmail(config, tokenPath)
+
+	token := os.Getenv("JIRA_API_TOKEN")
+	if token == "" && tokenPath != "" {
+		token, _ = readOnePasswordSecret(tokenPath)
+	}
+	return email, token
+}
+
+// reportProjectAccess checks each project key on JIRA, printing its name on success,
+// and returns the number of projects that are missing or inaccessible
+func reportProjectAccess(jiraURL, email, token string, projects []string) int {
+	failures := 0
+	for _, key := range projects {
+		project, err := jira.FetchProject(jiraURL, email, token, key)
+		if err != nil {
+			fmt.Printf("⚠️  %v\n", err)
+			failures++
+			continue
+		}
+		fmt.Printf("✅ Project %s exists: %s\n", key, project.Name)
+	}
+	return failures
+}
+
+// reportPickerStatuses checks that each configured picker_statuses entry is a status on
+// the instance (a missing one makes the picker's JQL fail) and returns how many are not
+func reportPickerStatuses(jiraURL, email, token string, statuses []string) int {
+	if len(statuses) == 0 {
+		return 0
+	}
+	known, err := jira.FetchStatusNames(jiraURL, email, token)
+	if err != nil {
+		fmt.Printf("ℹ️  Could not verify picker_statuses: %v\n", err)
+		return 0
+	}
+	failures := 0
+	for _, status := range statuses {
+		found := false
+		for _, name := range known {
+			if strings.EqualFold(name, status) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			fmt.Printf("⚠️  picker_statuses entry %q is not a status on this JIRA instance\n", status)
+			failures++
+		}
+	}
+	if failures == 0 {
+		fmt.Printf("✅ Picker statuses exist: %s\n", strings.Join(statuses, ", "))
+	}
+	return failures
+}
+
 func runVersion(cmd *cobra.Command, args []string) {
 	fmt.Println(version.GetVersionString())
 
@@ -1999,11 +4372,70 @@ func runVersion(cmd *cobra.Command, args []string) {
 			fmt.Printf("\n\033[33mUpdate available: %s (current: %s)\033[0m\n", result.NewVersion, version.GetShortVersion())
 			fmt.Println("\033[33mRun 'gci update' to upgrade.\033[0m")
 		}
-	case <-time.After(5 * time.Second):
+	case <-time.After(version.CheckTimeout()):
 		// Don't block forever if GitHub is slow
 	}
 }
 
+// printUpdatePreview describes the release gci update would install and where
+func printUpdatePreview(w io.Writer, current, latestVersion string, release selfupdate.Release, exe string) {
+	fmt.Fprintf(w, "Would update %s -> %s\n", current, latestVersion)
+	if release.Name != "" {
+		fmt.Fprintf(w, "Release:   %s\n", release.Name)
+	}
+	if !release.PublishedAt.IsZero() {
+		fmt.Fprintf(w, "Published: %s\n", release.PublishedAt.Format("2006-01-02"))
+	}
+	if release.URL != "" {
+		fmt.Fprintf(w, "URL:       %s\n", release.URL)
+	}
+	if release.AssetName != "" {
+		fmt.Fprintf(w, "Asset:     %s\n", release.AssetName)
+	}
+	fmt.Fprintf(w, "Replaces:  %s\n", exe)
+
+	if notes := strings.TrimSpace(release.ReleaseNotes); notes != "" {
+		fmt.Fprintf(w, "\nRelease notes:\n%s\n", notes)
+	}
+	fmt.Fprintln(w, "\nDry run: nothing was changed. Run 'gci update' to install.")
+}
+
+// updateCheckTimeout parses update_check_timeout (e.g. "10s"); 0 means the default
+func updateCheckTimeout(config usercfg.Config) time.Duration {
+	if config.UpdateCheckTimeout == "" {
+		return 0
+	}
+	d, err := time.ParseDuration(config.UpdateCheckTimeout)
+	if err != nil {
+		logger.Config("ignoring invalid update_check_timeout %q: %v", config.UpdateCheckTimeout, err)
+		return 0
+	}
+	return d
+}
+
+// requestTimeout returns the timeout for every JIRA request: the flag, else
+// GCI_TIMEOUT, else none (each request keeps its own)
+func requestTimeout() time.Duration {
+	if timeoutFlag > 0 {
+		return timeoutFlag
+	}
+	env := os.Getenv("GCI_TIMEOUT")
+	if env == "" {
+		return 0
+	}
+	d, err := time.ParseDuration(env)
+	if err != nil {
+		// A bare number means seconds
+		seconds, convErr := strconv.Atoi(env)
+		if convErr != nil {
+			logger.Config("ignoring invalid GCI_TIMEOUT %q: %v", env, err)
+			return 0
+		}
+		d = time.Duration(seconds) * time.Second
+	}
+	return d
+}
+
 func runUpdate(cmd *cobra.Command, args []string) {
 	current := version.GetShortVersion()
 	if current == "dev" {
@@ -2049,6 +4481,11 @@ func runUpdate(cmd *cobra.Command, args []string) {
 		return
 	}
 
+	if updateDryRun {
+		printUpdatePreview(os.Stdout, current, latest.Version(), *latest, exe)
+		return
+	}
+
 	if err := updater.UpdateTo(context.Background(), latest, exe); err != nil {
 		fmt.Printf("Update failed: %v\n", err)
 		return
@@ -2057,6 +4494,91 @@ func runUpdate(cmd *cobra.Command, args []string) {
 	fmt.Printf("Updated to %s\n", latest.Version())
 }
 
+func runName(cmd *cobra.Command, args []string) error {
+	config, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	issue, err := fetchIssueDetails(config, strings.ToUpper(strings.TrimSpace(args[0])))
+	if err != nil {
+		return err
+	}
+	fmt.Println(createBranchName(config, issue))
+	return nil
+}
+
+func runInstallAlias(cmd *cobra.Command, args []string) {
+	// PATH doctor: where this binary lives vs what the shell will run
+	exe, exeErr := selfupdate.ExecutablePath()
+	if exeErr == nil {
+		fmt.Printf("Installed at: %s\n", exe)
+	}
+
+	onPath, lookErr := exec.LookPath("gci")
+	switch {
+	case lookErr != nil:
+		fmt.Println("\033[93mgci is not on your PATH.\033[0m")
+		if exeErr == nil {
+			fmt.Printf("  export PATH=\"%s:$PATH\"\n", filepath.Dir(exe))
+		} else {
+			fmt.Println("  export PATH=\"$HOME/.local/bin:$PATH\"")
+		}
+		fmt.Println("  Add that line to ~/.zshrc or ~/.bashrc to make it permanent.")
+	case exeErr == nil && !samePath(onPath, exe):
+		fmt.Printf("\033[93mNote: 'gci' on your PATH resolves to %s, not this binary.\033[0m\n", onPath)
+	default:
+		fmt.Printf("\033[92mgci is on your PATH (%s)\033[0m\n", onPath)
+	}
+
+	// Git alias
+	aliasKey := "alias." + aliasNameFlag
+	const aliasValue = "!gci"
+	existingOut, _ := exec.Command("git", "config", "--global", "--get", aliasKey).Output()
+	existing := strings.TrimSpace(string(existingOut))
+	if existing == aliasValue {
+		fmt.Printf("\033[92mGit alias already configured: git %s -> gci\033[0m\n", aliasNameFlag)
+		return
+	}
+	if existing != "" {
+		var overwrite bool
+		if err := survey.AskOne(&survey.Confirm{
+			Message: fmt.Sprintf("git %s is already aliased to %q. Replace it?", aliasNameFlag, existing),
+			Default: false,
+		}, &overwrite); err != nil || !overwrite {
+			fmt.Println("Left existing alias unchanged.")
+			return
+		}
+	}
+
+	if out, err := exec.Command("git", "config", "--global", aliasKey, aliasValue).CombinedOutput(); err != nil {
+		fmt.Printf("\033[91mFailed to set git alias: %s\033[0m\n", strings.TrimSpace(string(out)))
+		os.Exit(1)
+	}
+	fmt.Printf("\033[92mAdded git alias: git %s -> gci\033[0m\n", aliasNameFlag)
+}
+
+// samePath reports whether two paths refer to the same file after resolving symlinks
+func samePath(a, b string) bool {
+	if ra, err := filepath.EvalSymlinks(a); err == nil {
+		a = ra
+	}
+	if rb, err := filepath.EvalSymlinks(b); err == nil {
+		b = rb
+	}
+	return a == b
+}
+
+// containsString reports whether list contains s
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
 func min(a, b int) int {
 	if a < b {
 		return a
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"gci/internal/jira"
+	"gci/internal/logger"
+	"gci/internal/usercfg"
+	"gci/internal/version"
+
+	"github.com/spf13/cobra"
+)
+
+var pathsCmd = &cobra.Command{
+	Use:   "paths",
+	Short: "List every file gci reads or writes",
+	Long: `List the files gci reads or writes, whether each exists, and its size: the
+config file (and the legacy one), a repository's .gci.toml, config backups, the
+update and board discovery caches, and the --verbose debug log. Environment
+variables overriding the config are listed by name; their values aren't printed.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		printPaths(os.Stdout)
+		return nil
+	},
+}
+
+// configEnvVars are the environment variables that override config or credentials
+var configEnvVars = []string{
+	"GCI_PROJECTS", "GCI_DEFAULT_SCOPE", "GCI_DEFAULT_PROJECT", "GCI_JIRA_URL",
+	"GCI_OP_JIRA_TOKEN_PATH", "GCI_IGNORE_UI_PREFS", "GCI_TIMEOUT", "JIRA_API_TOKEN",
+}
+
+// printPaths writes one line per gci-managed file, then the active env overrides
+func printPaths(w io.Writer) {
+	repoConfig := usercfg.RepoConfigPath()
+	if repoConfig == "" {
+		repoConfig = "(none in this repository)"
+	}
+	files := []struct{ label, path string }{
+		{"Config", usercfg.Path()},
+		{"Legacy config", usercfg.LegacyPath()},
+		{"Repo config", repoConfig},
+		{"Config backups", usercfg.BackupDir()},
+		{"Update cache", version.UpdateCachePath()},
+		{"Board cache", jira.CacheFilePath()},
+		{"Debug log", logger.DebugLogPath()},
+	}
+	for _, f := range files {
+		fmt.Fprintf(w, "%-15s %s%s\n", f.label+":", f.path, describePath(f.path))
+	}
+
+	var set []string
+	for _, name := range configEnvVars {
+		if os.Getenv(name) != "" {
+			set = append(set, name)
+		}
+	}
+	if len(set) == 0 {
+		fmt.Fprintf(w, "%-15s none\n", "Env overrides:")
+		return
+	}
+	fmt.Fprintf(w, "%-15s %s\n", "Env overrides:", strings.Join(set, ", "))
+}
+
+// describePath returns " (size)", " (N files)" for a directory, or " (missing)"
+func describePath(path string) string {
+	info, err := os.Stat(path)
+	if err != nil {
+		if path == "" || path[0] == '(' {
+			return ""
+		}
+		return " (missing)"
+	}
+	if info.IsDir() {
+		entries, _ := os.ReadDir(path)
+		return fmt.Sprintf(" (%d files)", len(entries))
+	}
+	return fmt.Sprintf(" (%s)", formatSize(info.Size()))
+}
+
+// formatSize renders a byte count as B, KB, or MB
+func formatSize(n int64) string {
+	switch {
+	case n < 1024:
+		return fmt.Sprintf("%d B", n)
+	case n < 1024*1024:
+		return fmt.Sprintf("%.1f KB", float64(n)/1024)
+	default:
+		return fmt.Sprintf("%.1f MB", float64(n)/(1024*1024))
+	}
+}
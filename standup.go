@@ -0,0 +1,170 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+	"time"
+
+	"gci/internal/jira"
+	"gci/internal/logger"
+
+	"github.com/spf13/cobra"
+)
+
+var standupJSON bool
+
+var standupCmd = &cobra.Command{
+	Use:   "standup",
+	Short: "Summarize yesterday, today, and what's blocked for a daily standup",
+	Long: `Print three sections for a daily standup:
+
+  Yesterday  issues you moved between statuses, or that are assigned to you and were
+             updated, on the previous working day (Friday on a Monday)
+  Today      issues assigned to you that are in progress
+  Blocked    unfinished issues assigned to you that are flagged or in a Blocked status
+
+Flagged issues need flagged_field, as on the board.`,
+	Example: `  gci standup
+  gci standup --json`,
+	RunE: runStandup,
+}
+
+// standupReport is the --json representation of gci standup, one array per section
+type standupReport struct {
+	Yesterday []todayIssue `json:"yesterday"`
+	Today     []todayIssue `json:"today"`
+	Blocked   []todayIssue `json:"blocked"`
+}
+
+// standupLookback returns how many days back the previous working day is: Friday for
+// Saturday through Monday, else yesterday
+func standupLookback(now time.Time) int {
+	switch now.Weekday() {
+	case time.Monday:
+		return 3
+	case time.Sunday:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// standupQueries returns the JQL for the yesterday, today, and blocked sections.
+// blockedStatuses are the instance's statuses named like "Blocked"; blocked is "" when
+// neither they nor flagged_field can match anything. No ORDER BY: fetchIssuesWithJQL
+// wraps each query after a project filter.
+func standupQueries(config *Config, now time.Time, blockedStatuses []string) (yesterday, today, blocked string) {
+	since := fmt.Sprintf("startOfDay(-%d)", standupLookback(now))
+	yesterday = fmt.Sprintf("status CHANGED BY currentUser() DURING (%s, startOfDay()) OR (assignee = currentUser() AND updated >= %s AND updated < startOfDay())", since, since)
+	today = fmt.Sprintf("assignee = currentUser() AND statusCategory = \"%s\"",
+		config.statusCategoryName(jira.StatusCategoryIndeterminate))
+
+	// The same test as isBlockedIssue, in JQL
+	var conditions []string
+	if len(blockedStatuses) > 0 {
+		var quoted []string
+		for _, status := range blockedStatuses {
+			quoted = append(quoted, fmt.Sprintf("\"%s\"", strings.ReplaceAll(status, `"`, `\"`)))
+		}
+		conditions = append(conditions, fmt.Sprintf("status in (%s)", strings.Join(quoted, ", ")))
+	}
+	if config.FlaggedField != "" {
+		conditions = append(conditions, jqlFieldName(config.FlaggedField)+" is not EMPTY")
+	}
+	if len(conditions) > 0 {
+		blocked = fmt.Sprintf("assignee = currentUser() AND statusCategory != \"%s\" AND (%s)",
+			config.statusCategoryName(jira.StatusCategoryDone), strings.Join(conditions, " OR "))
+	}
+	return yesterday, today, blocked
+}
+
+// blockedStatusNames keeps the status names isBlockedIssue treats as blocked
+func blockedStatusNames(names []string) []string {
+	var blocked []string
+	for _, name := range names {
+		if strings.Contains(strings.ToLower(name), "blocked") {
+			blocked = append(blocked, name)
+		}
+	}
+	return blocked
+}
+
+// jqlFieldName turns a custom field id (customfield_10021) into its JQL name (cf[10021])
+func jqlFieldName(id string) string {
+	if number, ok := strings.CutPrefix(id, "customfield_"); ok {
+		return "cf[" + number + "]"
+	}
+	return id
+}
+
+// writeStandup prints each section's heading and table, or "(none)" when it's empty
+func writeStandup(w io.Writer, yesterday, today, blocked []JiraIssue) error {
+	sections := []struct {
+		title  string
+		issues []JiraIssue
+	}{{"Yesterday", yesterday}, {"Today", today}, {"Blocked", blocked}}
+	for i, section := range sections {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "%s\n", section.title)
+		if len(section.issues) == 0 {
+			fmt.Fprintln(w, "  (none)")
+			continue
+		}
+		if err := writeTodayTable(w, section.issues); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func runStandup(cmd *cobra.Command, args []string) error {
+	config, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	resolveStatusCategoryNames(config)
+
+	statuses, err := jira.FetchStatusNames(config.JiraURL, config.Email, config.APIToken)
+	if err != nil {
+		logger.JIRA("status lookup failed, blocked issues are found by flag only: %v", err)
+	}
+
+	yesterdayJQL, todayJQL, blockedJQL := standupQueries(config, time.Now(), blockedStatusNames(statuses))
+	yesterday, err := fetchIssuesWithJQL(config, yesterdayJQL, 50)
+	if err != nil {
+		return err
+	}
+	today, err := fetchIssuesWithJQL(config, todayJQL, 50)
+	if err != nil {
+		return err
+	}
+	// Every blocked issue: the query matches only those, so there's nothing to cap
+	var blocked []JiraIssue
+	if blockedJQL != "" {
+		if blocked, err = fetchIssuesWithJQL(config, blockedJQL, math.MaxInt); err != nil {
+			return err
+		}
+	}
+
+	if standupJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(standupReport{
+			Yesterday: todayRows(config, yesterday),
+			Today:     todayRows(config, today),
+			Blocked:   todayRows(config, blocked),
+		})
+	} else {
+		err = writeStandup(os.Stdout, yesterday, today, blocked)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write output: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStandupQueries(t *testing.T) {
+	config := &Config{}
+	monday := time.Date(2026, 10, 12, 9, 0, 0, 0, time.UTC)
+
+	yesterday, today, blocked := standupQueries(config, monday, nil)
+	if !strings.Contains(yesterday, "DURING (startOfDay(-3), startOfDay())") {
+		t.Errorf("on a Monday, yesterday should reach back to Friday: %s", yesterday)
+	}
+	if today != `assignee = currentUser() AND statusCategory = "In Progress"` {
+		t.Errorf("today JQL = %s", today)
+	}
+	if blocked != "" {
+		t.Errorf("without a Blocked status or flagged_field nothing can be blocked, got %s", blocked)
+	}
+
+	config.FlaggedField = "customfield_10021"
+	_, _, blocked = standupQueries(config, monday, blockedStatusNames([]string{"To Do", "Blocked", "Blocked by vendor"}))
+	if blocked != `assignee = currentUser() AND statusCategory != "Done" AND (status in ("Blocked", "Blocked by vendor") OR cf[10021] is not EMPTY)` {
+		t.Errorf("blocked JQL = %s", blocked)
+	}
+
+	if yesterday, _, _ := standupQueries(config, monday.AddDate(0, 0, 2), nil); !strings.Contains(yesterday, "startOfDay(-1)") {
+		t.Errorf("on a Wednesday, yesterday should be Tuesday: %s", yesterday)
+	}
+}
+
+func TestWriteStandup(t *testing.T) {
+	issue := JiraIssue{Key: "TEST-1"}
+	issue.Fields.Summary = "Fix login"
+	issue.Fields.Status.Name = "In Progress"
+
+	var out bytes.Buffer
+	if err := writeStandup(&out, nil, []JiraIssue{issue}, nil); err != nil {
+		t.Fatalf("writeStandup failed: %v", err)
+	}
+	want := "Yesterday\n  (none)\n\nToday\nKEY     STATUS       SUMMARY\nTEST-1  In Progress  Fix login\n\nBlocked\n  (none)\n"
+	if out.String() != want {
+		t.Errorf("writeStandup:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
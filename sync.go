@@ -0,0 +1,207 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"gci/internal/errors"
+	"gci/internal/jira"
+	"gci/internal/usercfg"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+)
+
+var syncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "List local issue branches whose issues are done, and delete the merged ones",
+	Long: `Find local branches named after an issue (KEY-123_summary), look up each issue's
+status in batches, and report the branches whose issues are in the Done status
+category. Done branches already merged into the default branch can be deleted
+after confirmation; unmerged ones are only listed.`,
+	Example: `  gci sync`,
+	RunE:    runSync,
+}
+
+// syncBranch is a local branch named after an issue, with the issue's current status
+type syncBranch struct {
+	Branch string
+	Key    string
+	Status string // empty when the issue wasn't found
+	Done   bool
+	Merged bool
+}
+
+// issueBranches maps issue keys to the local branches named after them
+func issueBranches(config *Config, branches []string) map[string][]string {
+	byKey := make(map[string][]string)
+	for _, branch := range branches {
+		if key := issueKeyFromBranch(config, branch); key != "" {
+			byKey[key] = append(byKey[key], branch)
+		}
+	}
+	return byKey
+}
+
+// planSync pairs each issue branch with its issue's status, sorted by branch name
+func planSync(byKey map[string][]string, issues map[string]JiraIssue, merged map[string]bool) []syncBranch {
+	var rows []syncBranch
+	for key, branches := range byKey {
+		issue, ok := issues[key]
+		for _, branch := range branches {
+			row := syncBranch{Branch: branch, Key: key, Merged: merged[branch]}
+			if ok {
+				row.Status = issue.Fields.Status.Name
+				row.Done = issue.Fields.Status.StatusCategory.Key == jira.StatusCategoryDone
+			}
+			rows = append(rows, row)
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].Branch < rows[j].Branch })
+	return rows
+}
+
+// writeSyncTable prints branches as BRANCH / ISSUE / STATUS / MERGED columns
+func writeSyncTable(w io.Writer, rows []syncBranch) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "BRANCH\tISSUE\tSTATUS\tMERGED")
+	for _, row := range rows {
+		status := row.Status
+		if status == "" {
+			status = "(not found)"
+		}
+		merged := "no"
+		if row.Merged {
+			merged = "yes"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", row.Branch, row.Key, status, merged)
+	}
+	return tw.Flush()
+}
+
+// localBranches lists local branch names
+func localBranches() ([]string, error) {
+	out, err := exec.Command("git", "for-each-ref", "--format=%(refname:short)", "refs/heads/").Output()
+	if err != nil {
+		return nil, err
+	}
+	return strings.Fields(string(out)), nil
+}
+
+// defaultBaseBranch returns the branch merged work lands on: origin's HEAD if known,
+// else a local main or master
+func defaultBaseBranch() string {
+	if out, err := exec.Command("git", "symbolic-ref", "--quiet", "--short", "refs/remotes/origin/HEAD").Output(); err == nil {
+		return strings.TrimSpace(string(out))
+	}
+	for _, name := range []string{"main", "master"} {
+		if exec.Command("git", "rev-parse", "--verify", "--quiet", "refs/heads/"+name).Run() == nil {
+			return name
+		}
+	}
+	return ""
+}
+
+// mergedBranches returns the local branches fully merged into base
+func mergedBranches(base string) map[string]bool {
+	merged := make(map[string]bool)
+	if base == "" {
+		return merged
+	}
+	out, err := exec.Command("git", "branch", "--merged", base, "--format=%(refname:short)").Output()
+	if err != nil {
+		return merged
+	}
+	for _, branch := range strings.Fields(string(out)) {
+		merged[branch] = true
+	}
+	return merged
+}
+
+func runSync(cmd *cobra.Command, args []string) error {
+	if err := exec.Command("git", "rev-parse", "--git-dir").Run(); err != nil {
+		return fmt.Errorf("not in a git repository")
+	}
+	branches, err := localBranches()
+	if err != nil {
+		return fmt.Errorf("failed to list branches: %w", err)
+	}
+	// Finding issue branches needs only branch_key_case; credentials wait until there are some
+	byKey := issueBranches(&Config{BranchKeyCase: usercfg.GetRuntimeConfig().BranchKeyCase}, branches)
+	if len(byKey) == 0 {
+		fmt.Println("No local branches named after an issue.")
+		return nil
+	}
+
+	config, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(byKey))
+	for key := range byKey {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	issues, err := fetchIssuesByKeys(config, keys, "status")
+	if err != nil {
+		return errors.WrapWithContext(err, "jira_connection")
+	}
+
+	base := defaultBaseBranch()
+	rows := planSync(byKey, issues, mergedBranches(base))
+	var done []syncBranch
+	for _, row := range rows {
+		if row.Done {
+			done = append(done, row)
+		}
+	}
+	if len(done) == 0 {
+		fmt.Printf("None of %d issue branch(es) are for done issues.\n", len(rows))
+		return nil
+	}
+	if base == "" {
+		fmt.Println("No origin/HEAD, main, or master found; merged status is unknown.")
+	} else {
+		fmt.Printf("Branches for done issues (merged means merged into %s):\n", base)
+	}
+	if err := writeSyncTable(os.Stdout, done); err != nil {
+		return fmt.Errorf("failed to write output: %w", err)
+	}
+
+	current := getCurrentBranch()
+	var deletable []string
+	for _, row := range done {
+		if row.Merged && row.Branch != current {
+			deletable = append(deletable, row.Branch)
+		}
+	}
+	if len(deletable) == 0 {
+		fmt.Println("\nNo merged branches to delete. Delete unmerged ones yourself with: git branch -D <branch>")
+		return nil
+	}
+
+	var proceed bool
+	prompt := &survey.Confirm{Message: fmt.Sprintf("Delete %d merged branch(es)?", len(deletable)), Default: false}
+	if err := survey.AskOne(prompt, &proceed); err != nil || !proceed {
+		fmt.Println("Cancelled")
+		return nil
+	}
+	for _, branch := range deletable {
+		var stderr bytes.Buffer
+		// -D: the merged check above is against the base branch, which may not be HEAD
+		deleteCmd := exec.Command("git", "branch", "-D", branch)
+		deleteCmd.Stderr = &stderr
+		if err := deleteCmd.Run(); err != nil {
+			fmt.Printf("\033[91mFailed to delete %s: %s\033[0m\n", branch, strings.TrimSpace(stderr.String()))
+			continue
+		}
+		notef("\033[92mDeleted %s\033[0m\n", branch)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestPlanSync(t *testing.T) {
+	byKey := issueBranches(&Config{}, []string{"main", "TEST-1_fix-login", "TEST-1_followup", "TEST-2_search", "TEST-3_gone", "wip"})
+	if len(byKey) != 3 || len(byKey["TEST-1"]) != 2 {
+		t.Fatalf("unexpected branch grouping: %v", byKey)
+	}
+
+	var done, open JiraIssue
+	done.Key = "TEST-1"
+	done.Fields.Status.Name = "Closed"
+	done.Fields.Status.StatusCategory.Key = "done"
+	open.Key = "TEST-2"
+	open.Fields.Status.Name = "In Review"
+	open.Fields.Status.StatusCategory.Key = "indeterminate"
+
+	rows := planSync(byKey, map[string]JiraIssue{"TEST-1": done, "TEST-2": open}, map[string]bool{"TEST-1_fix-login": true})
+	if len(rows) != 4 || rows[0].Branch != "TEST-1_fix-login" {
+		t.Fatalf("rows should cover every issue branch sorted by name, got %+v", rows)
+	}
+	if !rows[0].Done || !rows[0].Merged || !rows[1].Done || rows[1].Merged {
+		t.Errorf("TEST-1 branches should be done, only fix-login merged: %+v", rows[:2])
+	}
+	if rows[2].Done || rows[3].Status != "" {
+		t.Errorf("open and unknown issues should not be done: %+v", rows[2:])
+	}
+
+	var buf bytes.Buffer
+	if err := writeSyncTable(&buf, rows); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "(not found)") {
+		t.Errorf("unknown issues should be labelled:\n%s", buf.String())
+	}
+}
+
+func TestFetchIssuesByKeys_RetriesRejectedBatch(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		jql := r.URL.Query().Get("jql")
+		queries = append(queries, jql)
+		if strings.Contains(jql, "GONE-1") {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"errorMessages":["An issue with key 'GONE-1' does not exist"]}`))
+			return
+		}
+		var resp JiraResponse
+		for _, key := range strings.Split(strings.TrimSuffix(strings.TrimPrefix(jql, "key in ("), ")"), ", ") {
+			resp.Issues = append(resp.Issues, JiraIssue{Key: key})
+		}
+		resp.IsLast = true
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	config := &Config{JiraURL: server.URL, Email: "test@example.com", APIToken: "test-token"}
+	found, err := fetchIssuesByKeys(config, []string{"TEST-1", "GONE-1", "TEST-2"}, "status")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 2 || found["TEST-1"].Key == "" || found["TEST-2"].Key == "" {
+		t.Errorf("expected TEST-1 and TEST-2, got %v", found)
+	}
+	if len(queries) != 4 || queries[0] != "key in (TEST-1, GONE-1, TEST-2)" {
+		t.Errorf("expected one batch then three single-key retries, got %v", queries)
+	}
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"gci/internal/jira"
+
+	"github.com/spf13/cobra"
+)
+
+var todayJSON bool
+
+var todayCmd = &cobra.Command{
+	Use:   "today",
+	Short: "Show issues you created today and everything you have in progress",
+	Long: `Print a compact list for a daily kickoff: issues you reported today plus
+issues assigned to you that are in progress, de-duplicated by key.`,
+	Example: `  gci today
+  gci today --json`,
+	RunE: runToday,
+}
+
+// todayIssue is the --json representation of a gci today row
+type todayIssue struct {
+	Key     string `json:"key"`
+	Status  string `json:"status"`
+	Summary string `json:"summary"`
+	URL     string `json:"url"`
+}
+
+// todayQueries returns the JQL for issues created today and issues in progress. No
+// ORDER BY: fetchIssuesWithJQL wraps the query in parentheses after a project filter.
+func todayQueries(config *Config) []string {
+	return []string{
+		"reporter = currentUser() AND created >= startOfDay()",
+		fmt.Sprintf("assignee = currentUser() AND statusCategory = \"%s\"",
+			config.statusCategoryName(jira.StatusCategoryIndeterminate)),
+	}
+}
+
+// mergeIssues concatenates issue lists, keeping the first occurrence of each key
+func mergeIssues(lists ...[]JiraIssue) []JiraIssue {
+	seen := make(map[string]struct{})
+	var merged []JiraIssue
+	for _, list := range lists {
+		for _, issue := range list {
+			if _, dup := seen[issue.Key]; dup {
+				continue
+			}
+			seen[issue.Key] = struct{}{}
+			merged = append(merged, issue)
+		}
+	}
+	return merged
+}
+
+// writeTodayTable prints issues as aligned KEY / STATUS / SUMMARY columns
+func writeTodayTable(w io.Writer, issues []JiraIssue) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "KEY\tSTATUS\tSUMMARY")
+	for _, issue := range issues {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", issue.Key, issue.Fields.Status.Name, issue.Fields.Summary)
+	}
+	return tw.Flush()
+}
+
+// todayRows converts issues to their --json rows
+func todayRows(config *Config, issues []JiraIssue) []todayIssue {
+	rows := make([]todayIssue, 0, len(issues))
+	for _, issue := range issues {
+		rows = append(rows, todayIssue{
+			Key:     issue.Key,
+			Status:  issue.Fields.Status.Name,
+			Summary: issue.Fields.Summary,
+			URL:     issueURL(config, issue.Key),
+		})
+	}
+	return rows
+}
+
+// writeTodayJSON prints issues as a JSON array
+func writeTodayJSON(w io.Writer, config *Config, issues []JiraIssue) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(todayRows(config, issues))
+}
+
+func runToday(cmd *cobra.Command, args []string) error {
+	config, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	resolveStatusCategoryNames(config)
+
+	var lists [][]JiraIssue
+	for _, jql := range todayQueries(config) {
+		issues, err := fetchIssuesWithJQL(config, jql, 50)
+		if err != nil {
+			return err
+		}
+		lists = append(lists, issues)
+	}
+	issues := mergeIssues(lists...)
+
+	if todayJSON {
+		err = writeTodayJSON(os.Stdout, config, issues)
+	} else if len(issues) == 0 {
+		fmt.Println("Nothing created today and nothing in progress.")
+	} else {
+		err = writeTodayTable(os.Stdout, issues)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write output: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestMergeIssues(t *testing.T) {
+	a := []JiraIssue{{Key: "TEST-1"}, {Key: "TEST-2"}}
+	b := []JiraIssue{{Key: "TEST-2"}, {Key: "TEST-3"}}
+
+	merged := mergeIssues(a, b)
+	var keys []string
+	for _, issue := range merged {
+		keys = append(keys, issue.Key)
+	}
+	if strings.Join(keys, ",") != "TEST-1,TEST-2,TEST-3" {
+		t.Errorf("Expected de-duplicated keys in order, got %v", keys)
+	}
+}
+
+func TestWriteToday(t *testing.T) {
+	config := &Config{JiraURL: "https://test.atlassian.net"}
+	issue := JiraIssue{Key: "TEST-1"}
+	issue.Fields.Summary = "Fix login"
+	issue.Fields.Status.Name = "In Progress"
+
+	var table bytes.Buffer
+	if err := writeTodayTable(&table, []JiraIssue{issue}); err != nil {
+		t.Fatalf("writeTodayTable failed: %v", err)
+	}
+	if !strings.Contains(table.String(), "TEST-1  In Progress  Fix login") {
+		t.Errorf("Unexpected table output:\n%s", table.String())
+	}
+
+	var out bytes.Buffer
+	if err := writeTodayJSON(&out, config, []JiraIssue{issue}); err != nil {
+		t.Fatalf("writeTodayJSON failed: %v", err)
+	}
+	var rows []todayIssue
+	if err := json.Unmarshal(out.Bytes(), &rows); err != nil {
+		t.Fatalf("Invalid JSON: %v", err)
+	}
+	if len(rows) != 1 || rows[0].URL != "https://test.atlassian.net/browse/TEST-1" {
+		t.Errorf("Unexpected JSON rows: %+v", rows)
+	}
+}
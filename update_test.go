@@ -0,0 +1,37 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	selfupdate "github.com/creativeprojects/go-selfupdate"
+)
+
+func TestPrintUpdatePreview(t *testing.T) {
+	release := selfupdate.Release{
+		Name:         "v1.5.0",
+		URL:          "https://github.com/kesensoy/gci/releases/tag/v1.5.0",
+		AssetName:    "gci_linux_amd64.tar.gz",
+		ReleaseNotes: "- Faster board loading\n",
+		PublishedAt:  time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	var out bytes.Buffer
+	printUpdatePreview(&out, "1.4.0", "1.5.0", release, "/usr/local/bin/gci")
+	got := out.String()
+
+	for _, want := range []string{
+		"Would update 1.4.0 -> 1.5.0",
+		"Published: 2026-03-01",
+		release.URL,
+		"Replaces:  /usr/local/bin/gci",
+		"- Faster board loading",
+		"nothing was changed",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("preview missing %q:\n%s", want, got)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"gci/internal/usercfg"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+)
+
+var worktreeCmd = &cobra.Command{
+	Use:   "worktree",
+	Short: "Manage gci-created git worktrees",
+}
+
+var worktreeMigrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Move gci-created worktrees into worktree_base_dir",
+	Long: `Find worktrees gci created (named <repo>-<branch>) outside the configured
+worktree_base_dir, move them there, and repair git's worktree links.
+
+Worktrees with uncommitted changes are skipped.`,
+	Example: `  gci config set worktree_base_dir ~/worktrees
+  gci worktree migrate`,
+	Run: runWorktreeMigrate,
+}
+
+// worktreeEntry is one worktree from `git worktree list --porcelain`
+type worktreeEntry struct {
+	Path   string
+	Branch string // short branch name; empty for detached HEAD
+}
+
+// worktreeMove is a planned relocation of a gci-created worktree
+type worktreeMove struct {
+	From   string
+	To     string
+	Branch string
+}
+
+// resolveWorktreeBaseDir returns the directory worktrees are created in: the configured
+// base dir (with ~ expanded), or the repo's parent so worktrees sit beside it
+func resolveWorktreeBaseDir(repoRoot, configured string) string {
+	if configured == "" {
+		return filepath.Dir(repoRoot)
+	}
+	if configured == "~" || strings.HasPrefix(configured, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			configured = filepath.Join(home, strings.TrimPrefix(configured, "~"))
+		}
+	}
+	if abs, err := filepath.Abs(configured); err == nil {
+		return abs
+	}
+	return configured
+}
+
+// parseWorktreeList parses `git worktree list --porcelain` output
+func parseWorktreeList(out string) []worktreeEntry {
+	var entries []worktreeEntry
+	for _, block := range strings.Split(strings.TrimSpace(out), "\n\n") {
+		var entry worktreeEntry
+		for _, line := range strings.Split(block, "\n") {
+			switch {
+			case strings.HasPrefix(line, "worktree "):
+				entry.Path = strings.TrimPrefix(line, "worktree ")
+			case strings.HasPrefix(line, "branch "):
+				entry.Branch = strings.TrimPrefix(strings.TrimPrefix(line, "branch "), "refs/heads/")
+			}
+		}
+		if entry.Path != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// planWorktreeMoves selects gci-created worktrees (named <repo>-<branch>) that are not
+// already in baseDir. The main worktree is never moved.
+func planWorktreeMoves(entries []worktreeEntry, repoRoot, baseDir string) []worktreeMove {
+	repoName := filepath.Base(repoRoot)
+	var moves []worktreeMove
+	for _, e := range entries {
+		if e.Path == repoRoot || e.Branch == "" {
+			continue
+		}
+		name := filepath.Base(e.Path)
+		if name != fmt.Sprintf("%s-%s", repoName, e.Branch) {
+			continue
+		}
+		if filepath.Dir(e.Path) == baseDir {
+			continue
+		}
+		moves = append(moves, worktreeMove{From: e.Path, To: filepath.Join(baseDir, name), Branch: e.Branch})
+	}
+	return moves
+}
+
+func runWorktreeMigrate(cmd *cobra.Command, args []string) {
+	rootOut, err := exec.Command("git", "rev-parse", "--show-toplevel").Output()
+	if err != nil {
+		fmt.Println("\033[91mNot in a git repository.\033[0m")
+		os.Exit(1)
+	}
+	repoRoot := strings.TrimSpace(string(rootOut))
+
+	config := usercfg.GetRuntimeConfig()
+	if config.WorktreeBaseDir == "" {
+		fmt.Println("worktree_base_dir is not set; worktrees already live beside the repo.")
+		fmt.Println("Set it first: gci config set worktree_base_dir ~/worktrees")
+		return
+	}
+	baseDir := resolveWorktreeBaseDir(repoRoot, config.WorktreeBaseDir)
+
+	listOut, err := exec.Command("git", "worktree", "list", "--porcelain").Output()
+	if err != nil {
+		fmt.Printf("\033[91mFailed to list worktrees: %v\033[0m\n", err)
+		os.Exit(1)
+	}
+
+	var moves []worktreeMove
+	for _, mv := range planWorktreeMoves(parseWorktreeList(string(listOut)), repoRoot, baseDir) {
+		statusOut, err := exec.Command("git", "-C", mv.From, "status", "--porcelain").Output()
+		if err != nil || len(strings.TrimSpace(string(statusOut))) > 0 {
+			fmt.Printf("Skipping %s (uncommitted changes or unreadable)\n", mv.From)
+			continue
+		}
+		if _, err := os.Stat(mv.To); err == nil {
+			fmt.Printf("Skipping %s (%s already exists)\n", mv.From, mv.To)
+			continue
+		}
+		moves = append(moves, mv)
+	}
+	if len(moves) == 0 {
+		fmt.Printf("No gci worktrees to move into %s.\n", baseDir)
+		return
+	}
+
+	fmt.Printf("Moving %d worktree(s) into %s:\n", len(moves), baseDir)
+	for _, mv := range moves {
+		fmt.Printf("  %s -> %s\n", mv.From, mv.To)
+	}
+	var proceed bool
+	if err := survey.AskOne(&survey.Confirm{Message: "Proceed?", Default: false}, &proceed); err != nil || !proceed {
+		fmt.Println("Cancelled")
+		return
+	}
+
+	if err := os.MkdirAll(baseDir, 0755); err != nil {
+		fmt.Printf("\033[91mFailed to create %s: %v\033[0m\n", baseDir, err)
+		os.Exit(1)
+	}
+
+	var moved []string
+	for _, mv := range moves {
+		var stderr bytes.Buffer
+		moveCmd := exec.Command("git", "worktree", "move", mv.From, mv.To)
+		moveCmd.Stderr = &stderr
+		if err := moveCmd.Run(); err != nil {
+			fmt.Printf("\033[91mFailed to move %s: %s\033[0m\n", mv.From, strings.TrimSpace(stderr.String()))
+			continue
+		}
+		fmt.Printf("\033[92mMoved %s\033[0m\n", mv.To)
+		moved = append(moved, mv.To)
+	}
+
+	if len(moved) > 0 {
+		repairArgs := append([]string{"worktree", "repair"}, moved...)
+		if out, err := exec.Command("git", repairArgs...).CombinedOutput(); err != nil {
+			fmt.Printf("\033[93mgit worktree repair reported: %s\033[0m\n", strings.TrimSpace(string(out)))
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestParseWorktreeList(t *testing.T) {
+	out := `worktree /src/app
+HEAD 1111111111111111111111111111111111111111
+branch refs/heads/main
+
+worktree /src/app-INF-1_fix-login
+HEAD 2222222222222222222222222222222222222222
+branch refs/heads/INF-1_fix-login
+
+worktree /tmp/scratch
+HEAD 3333333333333333333333333333333333333333
+detached
+`
+	entries := parseWorktreeList(out)
+	if len(entries) != 3 {
+		t.Fatalf("Expected 3 entries, got %d: %v", len(entries), entries)
+	}
+	if entries[1].Path != "/src/app-INF-1_fix-login" || entries[1].Branch != "INF-1_fix-login" {
+		t.Errorf("Unexpected entry: %+v", entries[1])
+	}
+	if entries[2].Branch != "" {
+		t.Errorf("Expected detached worktree to have no branch, got %q", entries[2].Branch)
+	}
+}
+
+func TestPlanWorktreeMoves(t *testing.T) {
+	entries := []worktreeEntry{
+		{Path: "/src/app", Branch: "main"},
+		{Path: "/src/app-INF-1_fix-login", Branch: "INF-1_fix-login"},
+		{Path: "/src/other-checkout", Branch: "INF-2_other"},
+		{Path: "/wt/app-INF-3_done", Branch: "INF-3_done"},
+		{Path: "/tmp/scratch"},
+	}
+
+	moves := planWorktreeMoves(entries, "/src/app", "/wt")
+	if len(moves) != 1 {
+		t.Fatalf("Expected 1 move, got %d: %v", len(moves), moves)
+	}
+	if moves[0].From != "/src/app-INF-1_fix-login" || moves[0].To != filepath.Join("/wt", "app-INF-1_fix-login") {
+		t.Errorf("Unexpected move: %+v", moves[0])
+	}
+}
+
+func TestResolveWorktreeBaseDir(t *testing.T) {
+	if got := resolveWorktreeBaseDir("/src/app", ""); got != "/src" {
+		t.Errorf("Expected default base dir to be the repo's parent, got %q", got)
+	}
+	if got := resolveWorktreeBaseDir("/src/app", "/wt"); got != "/wt" {
+		t.Errorf("Expected configured base dir, got %q", got)
+	}
+}